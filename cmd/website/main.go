@@ -1,14 +1,22 @@
 package main
 
 import (
+	"flag"
 	"log"
 
 	"github.com/benidevo/website/internal/app"
 	"github.com/benidevo/website/internal/config"
+	"github.com/joho/godotenv"
 )
 
 func main() {
-	cfg, err := config.SetupConfig()
+	configFile := flag.String("config", "", "path to a YAML or TOML config file (defaults to $CONFIG_FILE)")
+	flag.Parse()
+
+	// Load environment variables from .env file (ignore error if file doesn't exist)
+	_ = godotenv.Load()
+
+	cfg, err := config.SetupConfig(*configFile)
 	if err != nil {
 		log.Fatalf("Failed to setup configuration: %v", err)
 	}
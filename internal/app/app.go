@@ -12,6 +12,7 @@ import (
 
 	"github.com/benidevo/website/internal/config"
 	"github.com/benidevo/website/internal/router"
+	"github.com/benidevo/website/internal/tracing"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,6 +23,8 @@ type App struct {
 	router *gin.Engine
 	server *http.Server
 	done   chan os.Signal
+
+	shutdownTracing tracing.ShutdownFunc
 }
 
 func New(cfg *config.Config) *App {
@@ -36,6 +39,12 @@ func New(cfg *config.Config) *App {
 func (a *App) Setup() error {
 	log.Info().Msgf("Starting server on port %s", a.cfg.Settings.Port)
 
+	shutdownTracing, err := tracing.Setup(context.Background(), a.cfg.Settings.Tracing, a.cfg.Settings.IsDevelopment)
+	if err != nil {
+		return err
+	}
+	a.shutdownTracing = shutdownTracing
+
 	router, err := router.SetupRouter(a.cfg)
 	if err != nil {
 		return err
@@ -82,4 +91,10 @@ func (a *App) WaitForShutdown() {
 		log.Info().Msg("Server shutdown gracefully")
 	}
 
+	if a.shutdownTracing != nil {
+		if err := a.shutdownTracing(ctx); err != nil {
+			log.Error().Err(err).Msg("Failed to flush traces")
+		}
+	}
+
 }
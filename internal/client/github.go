@@ -1,21 +1,39 @@
 package client
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"path"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/metrics"
+	"github.com/benidevo/website/internal/requestid"
+	"github.com/benidevo/website/internal/tracing"
 )
 
-// CacheEntry represents a cached file with expiration
+// ErrNotFound is returned when a path does not exist in the content repository
+var ErrNotFound = errors.New("GitHub content not found")
+
+// CacheEntry represents a cached file with expiration and the validators GitHub
+// returned for it, which are replayed as conditional headers on refresh
 type CacheEntry struct {
-	Content   string
-	ExpiresAt time.Time
+	Content      string
+	ExpiresAt    time.Time
+	ETag         string
+	LastModified string
 }
 
 // GitHubClient provides common GitHub API functionality
@@ -24,7 +42,33 @@ type GitHubClient struct {
 	httpClient *http.Client
 	cache      map[string]CacheEntry
 	cacheMutex sync.RWMutex
-	cacheTTL   time.Duration
+	// Bumped by InvalidatePaths so fetches that started earlier do not store what they fetched
+	generations map[string]uint64
+	cacheTTL    time.Duration
+	maxStale    time.Duration // How long past expiry an entry may still be served
+
+	inflight      map[string]*inflightFetch // Fetches currently running, keyed by cache key
+	inflightMutex sync.Mutex
+
+	fetchHooks      map[string][]FetchHook // Callbacks for content GitHub returned, keyed by cache key
+	fetchHooksMutex sync.RWMutex
+
+	rateLimit      rateLimiter
+	health         healthTracker
+	maxRetries     int           // Retries for transient failures after the first attempt
+	retryBaseDelay time.Duration // Backoff before the first retry, doubled on each attempt
+}
+
+// FetchHook is called with the content GitHub returned for a path and when it returned it
+type FetchHook func(content string, fetchedAt time.Time)
+
+// inflightFetch is a GitHub fetch shared by every caller waiting on the same path
+type inflightFetch struct {
+	done    chan struct{}
+	content string
+	err     error
+	waiters int                // Callers still waiting for the result
+	cancel  context.CancelFunc // Cancels the fetch once every waiter has given up
 }
 
 // GitHubFile represents a file response from GitHub Contents API
@@ -33,56 +77,386 @@ type GitHubFile struct {
 	Encoding string `json:"encoding"`
 }
 
+// GitHubDirectoryEntry represents an item in a GitHub Contents API directory listing
+type GitHubDirectoryEntry struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
 // NewGitHubClient creates a new GitHub client
 func NewGitHubClient(cfg *config.GitHubConfig) *GitHubClient {
+	cacheTTL := cfg.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = 15 * time.Minute // Cache for 15 minutes
+	}
+
 	return &GitHubClient{
 		cfg: cfg,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		cache:    make(map[string]CacheEntry),
-		cacheTTL: 15 * time.Minute, // Cache for 15 minutes
+		cache:       make(map[string]CacheEntry),
+		generations: make(map[string]uint64),
+		cacheTTL:    cacheTTL,
+		maxStale:    cfg.CacheMaxStale,
+		inflight:    make(map[string]*inflightFetch),
+
+		fetchHooks: make(map[string][]FetchHook),
+
+		maxRetries:     defaultMaxRetries,
+		retryBaseDelay: defaultRetryBaseDelay,
 	}
 }
 
 // FetchFileContent fetches and decodes file content from GitHub repository with caching
-func (c *GitHubClient) FetchFileContent(filePath string) (string, error) {
+func (c *GitHubClient) FetchFileContent(ctx context.Context, filePath string) (string, error) {
+	return c.fetchCached(ctx, filePath, c.contentsURL(filePath), c.decodeFileResponse)
+}
+
+// ListDirectory returns the names of the files in a repository directory with caching
+func (c *GitHubClient) ListDirectory(ctx context.Context, dirPath string) ([]string, error) {
+	cacheKey := strings.TrimSuffix(dirPath, "/") + "/"
+
+	content, err := c.fetchCached(ctx, cacheKey, c.contentsURL(dirPath), func(body []byte) (string, error) {
+		return string(body), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []GitHubDirectoryEntry
+	if err := json.Unmarshal([]byte(content), &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode GitHub directory listing: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.Type == "file" {
+			names = append(names, e.Name)
+		}
+	}
+	return names, nil
+}
+
+// OnFetch registers hook to run whenever GitHub answers a request for filePath with
+// 200 or 304, but not when the content is served from the cache
+func (c *GitHubClient) OnFetch(filePath string, hook FetchHook) {
+	c.fetchHooksMutex.Lock()
+	defer c.fetchHooksMutex.Unlock()
+	c.fetchHooks[filePath] = append(c.fetchHooks[filePath], hook)
+}
+
+// runFetchHooks calls the hooks registered for key with freshly fetched content
+func (c *GitHubClient) runFetchHooks(key, content string, fetchedAt time.Time) {
+	c.fetchHooksMutex.RLock()
+	hooks := c.fetchHooks[key]
+	c.fetchHooksMutex.RUnlock()
+
+	for _, hook := range hooks {
+		hook(content, fetchedAt)
+	}
+}
+
+// fetchCached returns the cached content for key, fetching it from url when missing.
+//
+// Expired entries that are still within the max-stale window are served immediately
+// while a background refresh revalidates them; if that refresh fails the stale copy
+// keeps being served until the window closes. Older entries are refreshed synchronously.
+func (c *GitHubClient) fetchCached(ctx context.Context, key, url string, decode func([]byte) (string, error)) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubClient.fetchCached", attribute.String("github.path", key))
+	defer func() { tracing.End(span, err) }()
+
 	c.cacheMutex.RLock()
-	entry, exists := c.cache[filePath]
+	entry, exists := c.cache[key]
 	c.cacheMutex.RUnlock()
 
-	if exists && time.Now().Before(entry.ExpiresAt) {
+	now := time.Now()
+	if exists && now.Before(entry.ExpiresAt) {
+		metrics.ObserveCacheLookup(metrics.CacheHit)
+		span.SetAttributes(attribute.String("github.cache.result", metrics.CacheHit))
 		return entry.Content, nil
 	}
 
-	url := fmt.Sprintf("%s/repos/%s/%s/contents/%s",
-		c.cfg.BaseURL, c.cfg.Owner, c.cfg.Repository, filePath)
+	if exists && now.Before(entry.ExpiresAt.Add(c.maxStale)) {
+		metrics.ObserveCacheLookup(metrics.CacheStale)
+		span.SetAttributes(attribute.String("github.cache.result", metrics.CacheStale))
+		c.refreshInBackground(ctx, key, url, decode, entry)
+		return entry.Content, nil
+	}
 
-	file, err := c.fetchGitHubFile(url)
-	if err != nil {
-		return "", err
+	metrics.ObserveCacheLookup(metrics.CacheMiss)
+	span.SetAttributes(attribute.String("github.cache.result", metrics.CacheMiss))
+
+	var cached *CacheEntry
+	if exists {
+		cached = &entry
+	}
+	return c.refresh(ctx, key, url, decode, cached)
+}
+
+// refreshInBackground revalidates an expired entry unless a fetch for key is already running
+func (c *GitHubClient) refreshInBackground(ctx context.Context, key, url string, decode func([]byte) (string, error), entry CacheEntry) {
+	// The background refresh never leaves the fetch, so it is not cancelled with the request
+	call, fetchCtx := c.joinFetch(context.WithoutCancel(ctx), key)
+	if fetchCtx == nil {
+		return
+	}
+
+	go func() {
+		content, err := c.fetchAndStore(fetchCtx, key, url, decode, &entry)
+		c.finishFetch(key, call, content, err)
+
+		if err != nil {
+			log.Ctx(fetchCtx).Warn().Err(err).Str("path", key).
+				Time("expired_at", entry.ExpiresAt).
+				Msg("Background refresh failed, serving stale content")
+		}
+	}()
+}
+
+// refresh fetches key from GitHub, joining a fetch that is already in flight for the
+// same key so that only one request per path is ever outstanding.
+//
+// Each caller stops waiting as soon as its own context is done. The shared request
+// is only cancelled once every caller waiting on it has given up, so one abandoned
+// request cannot fail the others.
+func (c *GitHubClient) refresh(ctx context.Context, key, url string, decode func([]byte) (string, error), cached *CacheEntry) (string, error) {
+	call, fetchCtx := c.joinFetch(ctx, key)
+	if fetchCtx != nil {
+		go func() {
+			content, err := c.fetchAndStore(fetchCtx, key, url, decode, cached)
+			c.finishFetch(key, call, content, err)
+		}()
+	}
+
+	select {
+	case <-call.done:
+		return call.content, call.err
+	case <-ctx.Done():
+		c.leaveFetch(call)
+		return "", ctx.Err()
+	}
+}
+
+// joinFetch registers the caller as a waiter on the fetch for key. When no fetch is
+// running it starts one and returns the context the fetch must run with: it carries
+// the caller's values but is cancelled only by leaveFetch. fetchCtx is nil when the
+// caller joined a fetch that was already running.
+func (c *GitHubClient) joinFetch(ctx context.Context, key string) (call *inflightFetch, fetchCtx context.Context) {
+	c.inflightMutex.Lock()
+	defer c.inflightMutex.Unlock()
+
+	// A fetch with no waiters left has been cancelled, so start a fresh one instead
+	if call, exists := c.inflight[key]; exists && call.waiters > 0 {
+		call.waiters++
+		return call, nil
+	}
+
+	fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	call = &inflightFetch{
+		done:    make(chan struct{}),
+		waiters: 1,
+		cancel:  cancel,
+	}
+	c.inflight[key] = call
+	return call, fetchCtx
+}
+
+// leaveFetch removes a waiter that gave up, cancelling the fetch when none remain
+func (c *GitHubClient) leaveFetch(call *inflightFetch) {
+	c.inflightMutex.Lock()
+	defer c.inflightMutex.Unlock()
+
+	call.waiters--
+	if call.waiters == 0 {
+		call.cancel()
+	}
+}
+
+// finishFetch publishes the result of a fetch to its waiters
+func (c *GitHubClient) finishFetch(key string, call *inflightFetch, content string, err error) {
+	call.content, call.err = content, err
+
+	c.inflightMutex.Lock()
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
 	}
+	c.inflightMutex.Unlock()
 
-	content, err := c.decodeFileContent(file)
+	close(call.done)
+	call.cancel()
+}
+
+// fetchAndStore fetches key from GitHub and stores the result. When a cached entry is given
+// its validators make the request conditional and a 304 response extends the entry.
+//
+// Content fetched before an invalidation of key is returned to the caller but not
+// stored, so a fetch that was in flight during a push cannot cache the old content.
+func (c *GitHubClient) fetchAndStore(ctx context.Context, key, url string, decode func([]byte) (string, error), cached *CacheEntry) (string, error) {
+	generation := c.generation(key)
+
+	resp, err := c.fetchGitHub(ctx, url, cached)
 	if err != nil {
 		return "", err
 	}
 
+	now := time.Now()
+	entry := CacheEntry{}
+	if resp.NotModified {
+		entry = *cached
+		entry.ExpiresAt = now.Add(c.cacheTTL)
+	} else {
+		content, err := decode(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		entry = CacheEntry{
+			Content:      content,
+			ExpiresAt:    now.Add(c.cacheTTL),
+			ETag:         resp.ETag,
+			LastModified: resp.LastModified,
+		}
+	}
+
+	if c.storeCacheEntry(key, entry, generation) {
+		c.runFetchHooks(key, entry.Content, now)
+	} else {
+		log.Ctx(ctx).Debug().Str("path", key).Msg("Path invalidated during fetch, not caching the result")
+	}
+	return entry.Content, nil
+}
+
+// generation returns how many times key has been invalidated
+func (c *GitHubClient) generation(key string) uint64 {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+	return c.generations[key]
+}
+
+// storeCacheEntry saves an entry in the cache unless key has been invalidated since
+// generation was read, and reports whether it did
+func (c *GitHubClient) storeCacheEntry(key string, entry CacheEntry, generation uint64) bool {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	if c.generations[key] != generation {
+		return false
+	}
+	c.cache[key] = entry
+	return true
+}
+
+// contentsURL builds the Contents API URL for a repository path
+func (c *GitHubClient) contentsURL(filePath string) string {
+	return fmt.Sprintf("%s/repos/%s/%s/contents/%s",
+		c.cfg.BaseURL, c.cfg.Owner, c.cfg.Repository, filePath)
+}
+
+// InvalidatePaths removes cached content for the given file paths along with
+// the cached listing of each parent directory, returning the number of entries removed.
+// Fetches already in flight for those keys keep serving their waiters but are no
+// longer joined by new callers, and their results are not cached.
+func (c *GitHubClient) InvalidatePaths(paths ...string) int {
+	var keys []string
+	for _, filePath := range paths {
+		keys = append(keys, filePath)
+		if dir := path.Dir(filePath); dir != "." {
+			keys = append(keys, dir+"/")
+		}
+	}
+
+	removed := 0
 	c.cacheMutex.Lock()
-	c.cache[filePath] = CacheEntry{
-		Content:   content,
-		ExpiresAt: time.Now().Add(c.cacheTTL),
+	for _, key := range keys {
+		c.generations[key]++
+		if _, exists := c.cache[key]; exists {
+			delete(c.cache, key)
+			removed++
+		}
 	}
 	c.cacheMutex.Unlock()
 
-	return content, nil
+	c.inflightMutex.Lock()
+	for _, key := range keys {
+		delete(c.inflight, key)
+	}
+	c.inflightMutex.Unlock()
+
+	metrics.ObserveCacheEvictions(removed)
+	return removed
+}
+
+// githubResponse holds the parts of a GitHub API response the client needs
+type githubResponse struct {
+	Body         []byte
+	ETag         string
+	LastModified string
+	NotModified  bool
 }
 
-// fetchGitHubFile makes a request to GitHub API and returns the file data
-func (c *GitHubClient) fetchGitHubFile(url string) (*GitHubFile, error) {
-	req, err := http.NewRequest("GET", url, nil)
+// decodeFileResponse parses a Contents API file response and decodes its content
+func (c *GitHubClient) decodeFileResponse(body []byte) (string, error) {
+	var file GitHubFile
+	if err := json.Unmarshal(body, &file); err != nil {
+		return "", fmt.Errorf("failed to decode GitHub response: %w", err)
+	}
+	return c.decodeFileContent(&file)
+}
+
+// fetchGitHub makes an authenticated GET request to the GitHub API. When a cached
+// entry is given its validators are sent as If-None-Match/If-Modified-Since headers.
+//
+// Requests fail fast with ErrRateLimited while the client is backing off from a rate
+// limit, and transient network or 5xx failures are retried with jittered backoff.
+func (c *GitHubClient) fetchGitHub(ctx context.Context, url string, cached *CacheEntry) (_ *githubResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "GitHubClient.fetchGitHub",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodGet,
+			semconv.URLFull(url),
+			attribute.Bool("github.conditional", cached != nil),
+		))
+	defer func() { tracing.End(span, err) }()
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := retryDelay(c.retryBaseDelay, attempt-1)
+			span.AddEvent("retry", trace.WithAttributes(
+				attribute.Int("attempt", attempt+1),
+				attribute.String("delay", delay.String()),
+			))
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+
+		if until, blocked := c.rateLimit.blockedUntil(time.Now()); blocked {
+			metrics.ObserveUpstreamError(metrics.UpstreamRateLimited)
+			return nil, fmt.Errorf("%w: retry after %s", ErrRateLimited, until.Format(time.RFC3339))
+		}
+
+		resp, retryable, err := c.doRequest(ctx, url, cached)
+		if err == nil {
+			span.SetAttributes(attribute.Bool("github.not_modified", resp.NotModified))
+			return resp, nil
+		}
+
+		lastErr = err
+		if !retryable || ctx.Err() != nil {
+			break
+		}
+		log.Ctx(ctx).Debug().Err(err).Str("url", url).Int("attempt", attempt+1).Msg("Retrying GitHub request")
+	}
+	return nil, lastErr
+}
+
+// doRequest performs a single GitHub API request and reports whether a failure is worth retrying
+func (c *GitHubClient) doRequest(ctx context.Context, url string, cached *CacheEntry) (*githubResponse, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	if c.cfg.Token != "" {
@@ -90,24 +464,90 @@ func (c *GitHubClient) fetchGitHubFile(url string) (*GitHubFile, error) {
 	}
 	req.Header.Set("Accept", "application/vnd.github.v3+json")
 	req.Header.Set("User-Agent", "Portfolio-Website/1.0")
+	if id := requestid.FromContext(ctx); id != "" {
+		req.Header.Set(requestid.Header, id)
+	}
 
+	if cached != nil {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch file: %w", err)
+		err = fmt.Errorf("failed to fetch file: %w", err)
+		// A cancelled or timed out request says nothing about whether GitHub is reachable
+		if ctx.Err() != nil {
+			return nil, false, err
+		}
+		metrics.ObserveUpstreamRequest(0, time.Since(start))
+		metrics.ObserveUpstreamError(metrics.UpstreamNetworkError)
+		c.health.failed(time.Now(), err)
+		return nil, true, err
 	}
 	defer resp.Body.Close()
+	metrics.ObserveUpstreamRequest(resp.StatusCode, time.Since(start))
+	trace.SpanFromContext(ctx).SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+
+	body, readErr := io.ReadAll(resp.Body)
+
+	if c.rateLimit.observe(resp, body, time.Now()) {
+		metrics.ObserveUpstreamError(metrics.UpstreamRateLimited)
+		state := c.rateLimit.Snapshot()
+		log.Ctx(ctx).Warn().
+			Int("status", resp.StatusCode).
+			Time("blocked_until", state.BlockedUntil).
+			Msg("GitHub rate limit hit, backing off")
+		err := fmt.Errorf("%w: status %d, retry after %s",
+			ErrRateLimited, resp.StatusCode, state.BlockedUntil.Format(time.RFC3339))
+		c.health.failed(time.Now(), err)
+		return nil, false, err
+	}
+
+	// A server error means GitHub is unavailable; any other answer shows it is reachable
+	if resp.StatusCode >= http.StatusInternalServerError {
+		c.health.failed(time.Now(), fmt.Errorf("GitHub API returned status %d", resp.StatusCode))
+	} else {
+		c.health.succeeded(time.Now())
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return &githubResponse{NotModified: true}, false, nil
+	}
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("GitHub API returned status %d: %s", resp.StatusCode, string(body))
+		metrics.ObserveUpstreamError(metrics.UpstreamStatusError)
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, false, fmt.Errorf("%w: GitHub API returned status %d", ErrNotFound, resp.StatusCode)
+		}
+		return nil, isRetryableStatus(resp.StatusCode),
+			fmt.Errorf("GitHub API returned status %d: %s", resp.StatusCode, string(body))
 	}
 
-	var file GitHubFile
-	if err := json.NewDecoder(resp.Body).Decode(&file); err != nil {
-		return nil, fmt.Errorf("failed to decode GitHub response: %w", err)
+	if readErr != nil {
+		return nil, true, fmt.Errorf("failed to read GitHub response: %w", readErr)
 	}
 
-	return &file, nil
+	return &githubResponse{
+		Body:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, false, nil
+}
+
+// RateLimit returns the GitHub API quota as last reported by GitHub
+func (c *GitHubClient) RateLimit() RateLimitState {
+	return c.rateLimit.Snapshot()
+}
+
+// Health returns the outcome of the most recent GitHub API requests
+func (c *GitHubClient) Health() UpstreamHealth {
+	return c.health.Snapshot()
 }
 
 // decodeFileContent decodes base64 content from GitHub API response
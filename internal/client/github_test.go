@@ -1,15 +1,20 @@
 package client
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/requestid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewGitHubClient(t *testing.T) {
@@ -37,6 +42,7 @@ func TestGitHubClient_FetchFileContent(t *testing.T) {
 		serverStatus   int
 		wantContent    string
 		wantErr        bool
+		wantErrIs      error
 	}{
 		{
 			name:     "successful fetch and decode",
@@ -50,10 +56,17 @@ func TestGitHubClient_FetchFileContent(t *testing.T) {
 			wantErr:      false,
 		},
 		{
-			name:         "server returns error",
+			name:         "file not found",
 			filePath:     "test.txt",
 			serverStatus: http.StatusNotFound,
 			wantErr:      true,
+			wantErrIs:    ErrNotFound,
+		},
+		{
+			name:         "server returns error",
+			filePath:     "test.txt",
+			serverStatus: http.StatusUnauthorized,
+			wantErr:      true,
 		},
 	}
 
@@ -78,10 +91,15 @@ func TestGitHubClient_FetchFileContent(t *testing.T) {
 			}
 
 			client := NewGitHubClient(cfg)
-			content, err := client.FetchFileContent(tt.filePath)
+			content, err := client.FetchFileContent(context.Background(), tt.filePath)
 
 			if tt.wantErr {
 				assert.Error(t, err)
+				if tt.wantErrIs != nil {
+					assert.ErrorIs(t, err, tt.wantErrIs)
+				} else {
+					assert.NotErrorIs(t, err, ErrNotFound)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.wantContent, content)
@@ -90,6 +108,27 @@ func TestGitHubClient_FetchFileContent(t *testing.T) {
 	}
 }
 
+func TestGitHubClient_ForwardsRequestID(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(requestid.Header))
+		json.NewEncoder(w).Encode(GitHubFile{
+			Content:  base64.StdEncoding.EncodeToString([]byte("content")),
+			Encoding: "base64",
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{BaseURL: server.URL})
+
+	_, err := client.FetchFileContent(requestid.NewContext(context.Background(), "req-123"), "with-id.txt")
+	assert.NoError(t, err)
+	_, err = client.FetchFileContent(context.Background(), "without-id.txt")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"req-123", ""}, received)
+}
+
 func TestGitHubClient_Caching(t *testing.T) {
 	requestCount := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -111,13 +150,13 @@ func TestGitHubClient_Caching(t *testing.T) {
 	client := NewGitHubClient(cfg)
 
 	// First request
-	content1, err := client.FetchFileContent("test.txt")
+	content1, err := client.FetchFileContent(context.Background(), "test.txt")
 	assert.NoError(t, err)
 	assert.Equal(t, "cached content", content1)
 	assert.Equal(t, 1, requestCount)
 
 	// Second request should use cache
-	content2, err := client.FetchFileContent("test.txt")
+	content2, err := client.FetchFileContent(context.Background(), "test.txt")
 	assert.NoError(t, err)
 	assert.Equal(t, "cached content", content2)
 	assert.Equal(t, 1, requestCount) // No additional request
@@ -179,3 +218,403 @@ func TestRemoveWhitespace(t *testing.T) {
 		})
 	}
 }
+
+func TestGitHubClient_ListDirectory(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		assert.Contains(t, r.URL.Path, "/contents/posts")
+		json.NewEncoder(w).Encode([]GitHubDirectoryEntry{
+			{Name: "first.md", Path: "posts/first.md", Type: "file"},
+			{Name: "images", Path: "posts/images", Type: "dir"},
+			{Name: "second.md", Path: "posts/second.md", Type: "file"},
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	names, err := client.ListDirectory(context.Background(), "posts")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first.md", "second.md"}, names)
+
+	// Second listing should use cache
+	_, err = client.ListDirectory(context.Background(), "posts")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requestCount)
+}
+
+func TestGitHubClient_InvalidatePaths(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	client := &GitHubClient{
+		cache: map[string]CacheEntry{
+			"projects/projects.json": {Content: "projects", ExpiresAt: future},
+			"skills/skills.json":     {Content: "skills", ExpiresAt: future},
+			"posts/":                 {Content: "[]", ExpiresAt: future},
+			"posts/hello.md":         {Content: "hello", ExpiresAt: future},
+		},
+		generations: make(map[string]uint64),
+		inflight:    make(map[string]*inflightFetch),
+	}
+
+	removed := client.InvalidatePaths("projects/projects.json", "posts/hello.md", "README.md")
+
+	assert.Equal(t, 3, removed)
+	assert.Len(t, client.cache, 1)
+	assert.Contains(t, client.cache, "skills/skills.json")
+}
+
+func TestGitHubClient_InvalidatePathsDuringFetch(t *testing.T) {
+	var content atomic.Value
+	content.Store("before push")
+	var requests int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := content.Load().(string)
+		if atomic.AddInt32(&requests, 1) == 1 {
+			close(started)
+			<-release
+		}
+		json.NewEncoder(w).Encode(GitHubFile{
+			Content:  base64.StdEncoding.EncodeToString([]byte(body)),
+			Encoding: "base64",
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	stale := make(chan string)
+	go func() {
+		result, err := client.FetchFileContent(context.Background(), "test.txt")
+		assert.NoError(t, err)
+		stale <- result
+	}()
+	<-started
+
+	// A push lands while the first fetch is still waiting on GitHub
+	content.Store("after push")
+	client.InvalidatePaths("test.txt")
+
+	// New callers start their own fetch rather than joining the stale one
+	fresh, err := client.FetchFileContent(context.Background(), "test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "after push", fresh)
+
+	close(release)
+	assert.Equal(t, "before push", <-stale, "callers already waiting still get their result")
+
+	// ...but it is not cached over the newer content
+	cached, err := client.FetchFileContent(context.Background(), "test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "after push", cached)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestGitHubClient_ConditionalRefresh(t *testing.T) {
+	const etag = `"abc123"`
+	requestCount := 0
+	notModifiedCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.Header.Get("If-None-Match") == etag {
+			notModifiedCount++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		json.NewEncoder(w).Encode(GitHubFile{
+			Content:  base64.StdEncoding.EncodeToString([]byte("etag content")),
+			Encoding: "base64",
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	content, err := client.FetchFileContent(context.Background(), "test.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "etag content", content)
+	assert.Equal(t, etag, client.cache["test.txt"].ETag)
+	assert.Equal(t, "Mon, 01 Jan 2024 00:00:00 GMT", client.cache["test.txt"].LastModified)
+
+	// Expire the entry so the next call revalidates
+	entry := client.cache["test.txt"]
+	entry.ExpiresAt = time.Now().Add(-time.Minute)
+	client.cache["test.txt"] = entry
+
+	content, err = client.FetchFileContent(context.Background(), "test.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "etag content", content)
+	assert.Equal(t, 2, requestCount)
+	assert.Equal(t, 1, notModifiedCount)
+	assert.True(t, client.cache["test.txt"].ExpiresAt.After(time.Now()), "304 should extend the cache entry")
+}
+
+func TestGitHubClient_OnFetch(t *testing.T) {
+	const etag = `"abc123"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		json.NewEncoder(w).Encode(GitHubFile{
+			Content:  base64.StdEncoding.EncodeToString([]byte("hooked content")),
+			Encoding: "base64",
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	var fetched []string
+	client.OnFetch("test.txt", func(content string, fetchedAt time.Time) {
+		assert.WithinDuration(t, time.Now(), fetchedAt, time.Second)
+		fetched = append(fetched, content)
+	})
+
+	_, err := client.FetchFileContent(context.Background(), "test.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hooked content"}, fetched, "runs after a 200")
+
+	_, err = client.FetchFileContent(context.Background(), "test.txt")
+	assert.NoError(t, err)
+	assert.Len(t, fetched, 1, "does not run for a cache hit")
+
+	_, err = client.FetchFileContent(context.Background(), "other.txt")
+	assert.NoError(t, err)
+	assert.Len(t, fetched, 1, "does not run for other paths")
+
+	entry := client.cache["test.txt"]
+	entry.ExpiresAt = time.Now().Add(-time.Minute)
+	client.cache["test.txt"] = entry
+
+	_, err = client.FetchFileContent(context.Background(), "test.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hooked content", "hooked content"}, fetched, "runs after a 304")
+}
+
+func TestGitHubClient_StaleWhileRevalidate(t *testing.T) {
+	var mu sync.Mutex
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			json.NewEncoder(w).Encode(GitHubFile{
+				Content:  base64.StdEncoding.EncodeToString([]byte("fresh content")),
+				Encoding: "base64",
+			})
+		}
+	}))
+	defer server.Close()
+
+	newStaleClient := func() *GitHubClient {
+		client := NewGitHubClient(&config.GitHubConfig{
+			Owner:         "test-owner",
+			Repository:    "test-repo",
+			BaseURL:       server.URL,
+			CacheMaxStale: time.Hour,
+		})
+		client.retryBaseDelay = time.Millisecond
+		client.cache["test.txt"] = CacheEntry{
+			Content:   "stale content",
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}
+		return client
+	}
+
+	t.Run("serves stale content and refreshes in background", func(t *testing.T) {
+		client := newStaleClient()
+
+		content, err := client.FetchFileContent(context.Background(), "test.txt")
+		assert.NoError(t, err)
+		assert.Equal(t, "stale content", content)
+
+		assert.Eventually(t, func() bool {
+			client.cacheMutex.RLock()
+			defer client.cacheMutex.RUnlock()
+			return client.cache["test.txt"].Content == "fresh content"
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("keeps serving stale content when GitHub fails", func(t *testing.T) {
+		mu.Lock()
+		status = http.StatusBadGateway
+		mu.Unlock()
+
+		client := newStaleClient()
+
+		for i := 0; i < 3; i++ {
+			content, err := client.FetchFileContent(context.Background(), "test.txt")
+			assert.NoError(t, err)
+			assert.Equal(t, "stale content", content)
+		}
+
+		assert.Eventually(t, func() bool {
+			client.inflightMutex.Lock()
+			defer client.inflightMutex.Unlock()
+			return len(client.inflight) == 0
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, "stale content", client.cache["test.txt"].Content)
+	})
+
+	t.Run("returns error once content is older than max stale", func(t *testing.T) {
+		mu.Lock()
+		status = http.StatusBadGateway
+		mu.Unlock()
+
+		client := newStaleClient()
+		client.cache["test.txt"] = CacheEntry{
+			Content:   "ancient content",
+			ExpiresAt: time.Now().Add(-2 * time.Hour),
+		}
+
+		_, err := client.FetchFileContent(context.Background(), "test.txt")
+		assert.Error(t, err)
+	})
+}
+
+func TestGitHubClient_CoalescesConcurrentFetches(t *testing.T) {
+	var requestCount int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		<-release
+		json.NewEncoder(w).Encode(GitHubFile{
+			Content:  base64.StdEncoding.EncodeToString([]byte("shared content")),
+			Encoding: "base64",
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([]string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			content, err := client.FetchFileContent(context.Background(), "projects/projects.json")
+			assert.NoError(t, err)
+			results[i] = content
+		}(i)
+	}
+
+	// Wait until the first fetch reaches the server, then give the others time to join it
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requestCount) == 1
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requestCount))
+	for _, content := range results {
+		assert.Equal(t, "shared content", content)
+	}
+	assert.Empty(t, client.inflight)
+}
+
+func TestGitHubClient_ContextCancellation(t *testing.T) {
+	requestCancelled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		close(requestCancelled)
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.FetchFileContent(ctx, "slow.txt")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// With no callers left waiting, the upstream request is cancelled too
+	select {
+	case <-requestCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("upstream request was not cancelled")
+	}
+}
+
+func TestGitHubClient_CancelledWaiterDoesNotFailOthers(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		json.NewEncoder(w).Encode(GitHubFile{
+			Content:  base64.StdEncoding.EncodeToString([]byte("content")),
+			Encoding: "base64",
+		})
+	}))
+	defer server.Close()
+
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	impatient, cancel := context.WithCancel(context.Background())
+	impatientDone := make(chan error)
+	go func() {
+		_, err := client.FetchFileContent(impatient, "shared.txt")
+		impatientDone <- err
+	}()
+
+	patientDone := make(chan string)
+	go func() {
+		content, _ := client.FetchFileContent(context.Background(), "shared.txt")
+		patientDone <- content
+	}()
+
+	assert.Eventually(t, func() bool {
+		client.inflightMutex.Lock()
+		defer client.inflightMutex.Unlock()
+		call, exists := client.inflight["shared.txt"]
+		return exists && call.waiters == 2
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-impatientDone, context.Canceled)
+
+	close(release)
+	assert.Equal(t, "content", <-patientDone)
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// UpstreamHealth describes the outcome of the most recent GitHub API requests
+type UpstreamHealth struct {
+	LastSuccess time.Time `json:"last_success,omitzero"`
+	LastFailure time.Time `json:"last_failure,omitzero"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// Reachable reports whether the most recent request got an answer from GitHub.
+// It is false before any request has completed.
+func (h UpstreamHealth) Reachable() bool {
+	return !h.LastSuccess.IsZero() && !h.LastSuccess.Before(h.LastFailure)
+}
+
+// healthTracker records the outcome of GitHub API requests
+type healthTracker struct {
+	mu    sync.RWMutex
+	state UpstreamHealth
+}
+
+// Snapshot returns a copy of the current upstream health
+func (h *healthTracker) Snapshot() UpstreamHealth {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.state
+}
+
+// succeeded records a request that reached GitHub and got a usable answer
+func (h *healthTracker) succeeded(at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state.LastSuccess = at
+}
+
+// failed records a request that could not reach GitHub or was refused by it
+func (h *healthTracker) failed(at time.Time, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state.LastFailure = at
+	h.state.LastError = err.Error()
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/metrics"
+)
+
+func TestGitHubClient_TracksUpstreamHealth(t *testing.T) {
+	var status atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code := int(status.Load()); code != http.StatusOK {
+			w.WriteHeader(code)
+			return
+		}
+		writeTestFile(w, "content")
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.maxRetries = 0
+	assert.False(t, client.Health().Reachable(), "not reachable before any request")
+
+	steps := []struct {
+		path          string
+		status        int
+		wantReachable bool
+	}{
+		{path: "ok.txt", status: http.StatusOK, wantReachable: true},
+		{path: "down.txt", status: http.StatusServiceUnavailable, wantReachable: false},
+		{path: "missing.txt", status: http.StatusNotFound, wantReachable: true},
+	}
+
+	for _, step := range steps {
+		status.Store(int32(step.status))
+		_, _ = client.FetchFileContent(context.Background(), step.path)
+		assert.Equal(t, step.wantReachable, client.Health().Reachable(), step.path)
+	}
+
+	health := client.Health()
+	assert.Contains(t, health.LastError, "status 503")
+	assert.False(t, health.LastFailure.IsZero())
+}
+
+func TestGitHubClient_UnreachableOnNetworkError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := newTestClient(server.URL)
+	client.maxRetries = 0
+	_, err := client.FetchFileContent(context.Background(), "test.txt")
+
+	assert.Error(t, err)
+	assert.False(t, client.Health().Reachable())
+	assert.Contains(t, client.Health().LastError, "failed to fetch file")
+}
+
+// networkErrors scrapes the count of GitHub network errors from the metrics endpoint
+func networkErrors(t *testing.T) float64 {
+	t.Helper()
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	prefix := `website_github_request_errors_total{reason="network"} `
+	for _, line := range strings.Split(w.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, prefix); ok {
+			count, err := strconv.ParseFloat(value, 64)
+			require.NoError(t, err)
+			return count
+		}
+	}
+	return 0
+}
+
+func TestGitHubClient_CancelledRequestIsNotUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	before := networkErrors(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.FetchFileContent(ctx, "test.txt")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The shared fetch finishes in the background once its only waiter has left
+	assert.Eventually(t, func() bool {
+		client.inflightMutex.Lock()
+		defer client.inflightMutex.Unlock()
+		return len(client.inflight) == 0
+	}, time.Second, 5*time.Millisecond)
+
+	health := client.Health()
+	assert.True(t, health.LastFailure.IsZero())
+	assert.Empty(t, health.LastError)
+	assert.Equal(t, before, networkErrors(t))
+}
+
+func TestUpstreamState_OmitsUnsetTimes(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "upstream health", value: UpstreamHealth{}, want: `{}`},
+		{name: "rate limit", value: RateLimitState{}, want: `{"limit":0,"remaining":0,"used":0}`},
+		{
+			name:  "upstream health with failure",
+			value: UpstreamHealth{LastFailure: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), LastError: "status 503"},
+			want:  `{"last_failure":"2025-01-02T03:04:05Z","last_error":"status 503"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data))
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned without contacting GitHub while the client is backing off
+var ErrRateLimited = errors.New("GitHub rate limit exceeded")
+
+const (
+	defaultMaxRetries     = 3
+	defaultRetryBaseDelay = 250 * time.Millisecond
+	maxRetryDelay         = 5 * time.Second
+
+	// secondaryLimitBackoff is used when GitHub signals a secondary rate limit
+	// without telling us when to retry
+	secondaryLimitBackoff = time.Minute
+)
+
+// RateLimitState describes the GitHub API quota as last reported by GitHub
+type RateLimitState struct {
+	Limit        int       `json:"limit"`
+	Remaining    int       `json:"remaining"`
+	Used         int       `json:"used"`
+	Reset        time.Time `json:"reset,omitzero"`
+	BlockedUntil time.Time `json:"blocked_until,omitzero"`
+	UpdatedAt    time.Time `json:"updated_at,omitzero"`
+}
+
+// rateLimiter tracks the quota reported by GitHub and when requests may resume
+type rateLimiter struct {
+	mu    sync.RWMutex
+	state RateLimitState
+}
+
+// Snapshot returns a copy of the current rate limit state
+func (r *rateLimiter) Snapshot() RateLimitState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state
+}
+
+// blockedUntil returns the time until which requests should not be sent, if it is in the future
+func (r *rateLimiter) blockedUntil(now time.Time) (time.Time, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state.BlockedUntil, now.Before(r.state.BlockedUntil)
+}
+
+// observe records the X-RateLimit-* headers of a response and, for rate limited
+// responses, decides how long to back off. body is the response body, which is the
+// only sign of many secondary rate limits. It reports whether the response was rate limited.
+func (r *rateLimiter) observe(resp *http.Response, body []byte, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if limit, ok := headerInt(resp.Header, "X-RateLimit-Limit"); ok {
+		r.state.Limit = limit
+		r.state.UpdatedAt = now
+	}
+	if remaining, ok := headerInt(resp.Header, "X-RateLimit-Remaining"); ok {
+		r.state.Remaining = remaining
+		r.state.UpdatedAt = now
+	}
+	if used, ok := headerInt(resp.Header, "X-RateLimit-Used"); ok {
+		r.state.Used = used
+	}
+	if reset, ok := headerInt(resp.Header, "X-RateLimit-Reset"); ok {
+		r.state.Reset = time.Unix(int64(reset), 0)
+	}
+
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+
+	retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), now)
+	switch {
+	case hasRetryAfter:
+		r.state.BlockedUntil = now.Add(retryAfter)
+	case resp.Header.Get("X-RateLimit-Remaining") == "0" && r.state.Reset.After(now):
+		r.state.BlockedUntil = r.state.Reset
+	case resp.StatusCode == http.StatusTooManyRequests, isSecondaryRateLimit(body):
+		r.state.BlockedUntil = now.Add(secondaryLimitBackoff)
+	default:
+		// A plain 403 is a permissions problem, not a rate limit
+		return false
+	}
+
+	return true
+}
+
+// parseRetryAfter parses a Retry-After value given either in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(at.Sub(now), 0), true
+}
+
+// isSecondaryRateLimit reports whether a 403 body is GitHub's secondary rate limit
+// message, which is often sent without Retry-After or an exhausted quota
+func isSecondaryRateLimit(body []byte) bool {
+	return bytes.Contains(bytes.ToLower(body), []byte("secondary rate limit"))
+}
+
+// headerInt parses an integer header value
+func headerInt(header http.Header, key string) (int, bool) {
+	value := header.Get(key)
+	if value == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// isRetryableStatus reports whether a response status is a transient server error
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns the jittered exponential backoff delay before the given retry attempt (0-based)
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base << attempt
+	if delay <= 0 || delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	// Full jitter over the upper half keeps retries spread out without collapsing to zero
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
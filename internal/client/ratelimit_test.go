@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/benidevo/website/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(baseURL string) *GitHubClient {
+	client := NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    baseURL,
+	})
+	client.retryBaseDelay = time.Millisecond
+	return client
+}
+
+func writeTestFile(w http.ResponseWriter, content string) {
+	json.NewEncoder(w).Encode(GitHubFile{
+		Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+		Encoding: "base64",
+	})
+}
+
+func TestGitHubClient_TracksRateLimit(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Header().Set("X-RateLimit-Used", "1")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		writeTestFile(w, "content")
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.FetchFileContent(context.Background(), "test.txt")
+	assert.NoError(t, err)
+
+	state := client.RateLimit()
+	assert.Equal(t, 5000, state.Limit)
+	assert.Equal(t, 4999, state.Remaining)
+	assert.Equal(t, 1, state.Used)
+	assert.Equal(t, reset, state.Reset.Unix())
+	assert.True(t, state.BlockedUntil.IsZero())
+}
+
+func TestGitHubClient_BacksOffWhenRateLimited(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		headers map[string]string
+		body    string
+		want    time.Duration
+	}{
+		{
+			name:   "primary limit exhausted waits for reset",
+			status: http.StatusForbidden,
+			headers: map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(time.Now().Add(10*time.Minute).Unix(), 10),
+			},
+			want: 10 * time.Minute,
+		},
+		{
+			name:    "secondary limit honours Retry-After",
+			status:  http.StatusForbidden,
+			headers: map[string]string{"Retry-After": "120"},
+			want:    2 * time.Minute,
+		},
+		{
+			name:    "secondary limit honours an HTTP-date Retry-After",
+			status:  http.StatusForbidden,
+			headers: map[string]string{"Retry-After": time.Now().Add(3 * time.Minute).UTC().Format(http.TimeFormat)},
+			want:    3 * time.Minute,
+		},
+		{
+			name:    "secondary limit reported only in the body backs off for a minute",
+			status:  http.StatusForbidden,
+			headers: map[string]string{"X-RateLimit-Remaining": "4000"},
+			body:    `{"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`,
+			want:    secondaryLimitBackoff,
+		},
+		{
+			name:   "429 without hints backs off for a minute",
+			status: http.StatusTooManyRequests,
+			want:   secondaryLimitBackoff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				for k, v := range tt.headers {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(tt.status)
+				io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+
+			client := newTestClient(server.URL)
+
+			_, err := client.FetchFileContent(context.Background(), "test.txt")
+			assert.ErrorIs(t, err, ErrRateLimited)
+
+			blockedFor := time.Until(client.RateLimit().BlockedUntil)
+			assert.InDelta(t, tt.want.Seconds(), blockedFor.Seconds(), 5)
+
+			// Further requests fail fast without reaching GitHub
+			_, err = client.FetchFileContent(context.Background(), "other.txt")
+			assert.ErrorIs(t, err, ErrRateLimited)
+			assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestGitHubClient_ForbiddenIsNotRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4000")
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `{"message": "Resource not accessible by integration"}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	_, err := client.FetchFileContent(context.Background(), "test.txt")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrRateLimited)
+	assert.True(t, client.RateLimit().BlockedUntil.IsZero())
+}
+
+func TestGitHubClient_RetriesTransientErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int32
+		status       int
+		wantErr      bool
+		wantRequests int32
+	}{
+		{
+			name:         "recovers after transient 503s",
+			failures:     2,
+			status:       http.StatusServiceUnavailable,
+			wantRequests: 3,
+		},
+		{
+			name:         "gives up after max retries",
+			failures:     10,
+			status:       http.StatusBadGateway,
+			wantErr:      true,
+			wantRequests: defaultMaxRetries + 1,
+		},
+		{
+			name:         "does not retry client errors",
+			failures:     10,
+			status:       http.StatusNotFound,
+			wantErr:      true,
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) <= tt.failures {
+					w.WriteHeader(tt.status)
+					return
+				}
+				writeTestFile(w, "recovered")
+			}))
+			defer server.Close()
+
+			content, err := newTestClient(server.URL).FetchFileContent(context.Background(), "test.txt")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "recovered", content)
+			}
+			assert.Equal(t, tt.wantRequests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	base := 100 * time.Millisecond
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := retryDelay(base, attempt)
+		ceiling := base << attempt
+		if ceiling > maxRetryDelay {
+			ceiling = maxRetryDelay
+		}
+		assert.GreaterOrEqual(t, delay, ceiling/2)
+		assert.LessOrEqual(t, delay, ceiling)
+	}
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// readConfigFile decodes a YAML (.yaml, .yml) or TOML (.toml) file into a generic map
+func readConfigFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	values := map[string]interface{}{}
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	case ".toml":
+		err = toml.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q (use .yaml, .yml or .toml)", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return values, nil
+}
+
+// fileLookup maps config file values onto the env names of target's fields, so the file
+// can be layered beneath the environment and parsed by Load like any other source.
+// Keys follow the fields' json names, with nested structs as nested tables.
+func fileLookup(target interface{}, values map[string]interface{}) (LookupFunc, error) {
+	flattened := map[string]string{}
+	var unknown []string
+	flattenFileValues(reflect.TypeOf(target).Elem(), values, "", flattened, &unknown)
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown settings in config file: %s", strings.Join(unknown, ", "))
+	}
+
+	return func(key string) (string, bool) {
+		value, ok := flattened[key]
+		return value, ok
+	}, nil
+}
+
+// flattenFileValues walks values alongside structType, recording leaf values by env name
+func flattenFileValues(structType reflect.Type, values map[string]interface{}, prefix string, flattened map[string]string, unknown *[]string) {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if name := fileKey(field); field.IsExported() && name != "" {
+			fields[name] = field
+		}
+	}
+
+	for key, value := range values {
+		field, ok := fields[key]
+		if !ok {
+			*unknown = append(*unknown, prefix+key)
+			continue
+		}
+
+		if envName, hasEnv := field.Tag.Lookup("env"); hasEnv {
+			flattened[envName] = fileValueString(value)
+			continue
+		}
+
+		nested, isTable := value.(map[string]interface{})
+		if field.Type.Kind() != reflect.Struct || !isTable {
+			*unknown = append(*unknown, prefix+key)
+			continue
+		}
+		flattenFileValues(field.Type, nested, prefix+key+".", flattened, unknown)
+	}
+}
+
+// fileKey returns the name a field is known by in config files: its json name
+func fileKey(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+// fileValueString renders a decoded file value in the same form an environment variable would use
+func fileValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// layeredLookup returns the first non-empty value found, checking lookups in order
+func layeredLookup(lookups ...LookupFunc) LookupFunc {
+	return func(key string) (string, bool) {
+		for _, lookup := range lookups {
+			if value, ok := lookup(key); ok && value != "" {
+				return value, true
+			}
+		}
+		return "", false
+	}
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestNewSettingsFromConfigFile(t *testing.T) {
+	files := map[string]string{
+		"config.yaml": `
+port: "9000"
+is_development: false
+github:
+  owner: benidevo
+  cache_ttl: 5m
+content:
+  source: filesystem
+  directory: /srv/content
+`,
+		"config.toml": `
+port = 9000
+is_development = false
+
+[github]
+owner = "benidevo"
+cache_ttl = "5m"
+
+[content]
+source = "filesystem"
+directory = "/srv/content"
+`,
+	}
+
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("PORT", "")
+			t.Setenv("IS_DEVELOPMENT", "")
+			t.Setenv("GITHUB_OWNER", "")
+			t.Setenv("GITHUB_CACHE_TTL", "")
+			t.Setenv("CONTENT_SOURCE", "")
+			t.Setenv("CONTENT_DIR", "/override")
+
+			settings, err := NewSettings(writeConfigFile(t, name, content))
+
+			require.NoError(t, err)
+			assert.Equal(t, "9000", settings.Port)
+			assert.False(t, settings.IsDevelopment)
+			assert.Equal(t, "benidevo", settings.GitHub.Owner)
+			assert.Equal(t, 5*time.Minute, settings.GitHub.CacheTTL)
+			assert.Equal(t, 24*time.Hour, settings.GitHub.CacheMaxStale, "unset values keep their defaults")
+			assert.Equal(t, ContentSourceFilesystem, settings.Content.Source)
+			assert.Equal(t, "/override", settings.Content.Directory, "environment overrides the file")
+		})
+	}
+}
+
+func TestNewSettingsConfigFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		contains string
+	}{
+		{
+			name:     "unknown keys",
+			file:     "config.yaml",
+			content:  "prot: 80\ngithub:\n  ownr: me\n",
+			contains: "unknown settings in config file: github.ownr, prot",
+		},
+		{
+			name:     "malformed value",
+			file:     "config.toml",
+			content:  "[github]\ncache_ttl = \"soon\"\n",
+			contains: `GITHUB_CACHE_TTL: invalid value "soon"`,
+		},
+		{
+			name:     "invalid syntax",
+			file:     "config.yaml",
+			content:  "port: [",
+			contains: "failed to parse config file",
+		},
+		{
+			name:     "unsupported extension",
+			file:     "config.json",
+			content:  "{}",
+			contains: "unsupported config file extension",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITHUB_CACHE_TTL", "")
+
+			_, err := NewSettings(writeConfigFile(t, tt.file, tt.content))
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := NewSettings(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.ErrorContains(t, err, "failed to read config file")
+	})
+}
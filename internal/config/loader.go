@@ -0,0 +1,212 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LookupFunc returns the value of a configuration key and whether it is set, like os.LookupEnv
+type LookupFunc func(key string) (string, bool)
+
+// FieldError describes a configuration value that could not be applied to a field
+type FieldError struct {
+	Field string // Dotted Go field path, e.g. GitHub.CacheTTL
+	Env   string // Environment variable the value came from
+	Value string
+	Err   error
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: invalid value %q for %s: %v", e.Env, e.Value, e.Field, e.Err)
+}
+
+// LoadError aggregates every problem found while loading configuration
+type LoadError struct {
+	Invalid  []FieldError
+	Missing  []string  // Environment variables of required fields that were not set
+	Problems []Problem // Validation problems with the settings that did load
+}
+
+func (e *LoadError) Error() string {
+	problems := make([]string, 0, len(e.Invalid)+1+len(e.Problems))
+	for _, invalid := range e.Invalid {
+		problems = append(problems, invalid.Error())
+	}
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing required settings: "+strings.Join(e.Missing, ", "))
+	}
+	problems = append(problems, problemMessages(e.Problems)...)
+	return "invalid configuration:\n  " + strings.Join(problems, "\n  ")
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Load populates target, a pointer to a struct, from its field tags:
+//
+//	env:"NAME"          variable to read the value from
+//	default:"value"     value used when the variable is unset or empty
+//	required:"true"     report the variable as missing when no value or default exists
+//	options:"a,b,c"     reject values outside this list
+//
+// Nested structs are loaded recursively. Supported field types are string, bool,
+// signed and unsigned integers, floats, time.Duration and []string (comma separated).
+// All problems are collected and returned together as a *LoadError.
+func Load(target interface{}, lookup LookupFunc) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: Load requires a pointer to a struct, got %T", target)
+	}
+
+	loadErr := &LoadError{}
+	loadStruct(value.Elem(), "", lookup, loadErr)
+
+	if len(loadErr.Invalid) > 0 || len(loadErr.Missing) > 0 {
+		return loadErr
+	}
+	return nil
+}
+
+// failed reports whether env could not be loaded
+func (e *LoadError) failed(env string) bool {
+	for _, invalid := range e.Invalid {
+		if invalid.Env == env {
+			return true
+		}
+	}
+	for _, missing := range e.Missing {
+		if missing == env {
+			return true
+		}
+	}
+	return false
+}
+
+// loadStruct populates every tagged field of a struct value
+func loadStruct(value reflect.Value, prefix string, lookup LookupFunc, loadErr *LoadError) {
+	structType := value.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		fieldPath := prefix + field.Name
+
+		envName, hasEnv := field.Tag.Lookup("env")
+		if !hasEnv {
+			if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+				loadStruct(fieldValue, fieldPath+".", lookup, loadErr)
+			}
+			continue
+		}
+
+		raw, isSet := lookup(envName)
+		if !isSet || raw == "" {
+			raw, isSet = field.Tag.Lookup("default")
+		}
+
+		if !isSet {
+			if field.Tag.Get("required") == "true" {
+				loadErr.Missing = append(loadErr.Missing, envName)
+			}
+			continue
+		}
+
+		if err := checkOption(raw, field.Tag.Get("options")); err != nil {
+			loadErr.Invalid = append(loadErr.Invalid, FieldError{Field: fieldPath, Env: envName, Value: raw, Err: err})
+			continue
+		}
+
+		if err := setField(fieldValue, raw); err != nil {
+			loadErr.Invalid = append(loadErr.Invalid, FieldError{Field: fieldPath, Env: envName, Value: raw, Err: err})
+		}
+	}
+}
+
+// checkOption verifies that raw is one of the comma separated options, if any are declared
+func checkOption(raw, options string) error {
+	if options == "" {
+		return nil
+	}
+	for _, option := range strings.Split(options, ",") {
+		if raw == strings.TrimSpace(option) {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %s", strings.ReplaceAll(options, ",", ", "))
+}
+
+// setField parses raw according to the field's type and assigns it
+func setField(field reflect.Value, raw string) error {
+	if field.Type() == durationType {
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("expected a duration such as 30s or 15m")
+		}
+		field.SetInt(int64(duration))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := parseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected an integer")
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected a non-negative integer")
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected a number")
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", field.Type())
+		}
+		field.Set(reflect.ValueOf(splitList(raw)))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// parseBool accepts the strconv.ParseBool forms plus yes/no and on/off
+func parseBool(raw string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true, nil
+	case "0", "f", "false", "no", "n", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected a boolean (true/false, yes/no, on/off, 1/0)")
+}
+
+// splitList splits a comma separated list, trimming whitespace and dropping empty items
+func splitList(raw string) []string {
+	items := []string{}
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
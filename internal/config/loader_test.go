@@ -0,0 +1,136 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lookupFrom(values map[string]string) LookupFunc {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
+
+type loaderTestNested struct {
+	Timeout time.Duration `env:"TEST_TIMEOUT" default:"5s"`
+	Tags    []string      `env:"TEST_TAGS"`
+}
+
+type loaderTestConfig struct {
+	Name     string  `env:"TEST_NAME" default:"site"`
+	Enabled  bool    `env:"TEST_ENABLED" default:"false"`
+	Workers  int     `env:"TEST_WORKERS" default:"4"`
+	Ratio    float64 `env:"TEST_RATIO" default:"0.5"`
+	Mode     string  `env:"TEST_MODE" default:"fast" options:"fast,slow"`
+	Secret   string  `env:"TEST_SECRET" required:"true"`
+	Nested   loaderTestNested
+	internal string `env:"TEST_INTERNAL"`
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		var cfg loaderTestConfig
+		err := Load(&cfg, lookupFrom(map[string]string{"TEST_SECRET": "s3cret"}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "site", cfg.Name)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, 4, cfg.Workers)
+		assert.Equal(t, 0.5, cfg.Ratio)
+		assert.Equal(t, "fast", cfg.Mode)
+		assert.Equal(t, 5*time.Second, cfg.Nested.Timeout)
+		assert.Nil(t, cfg.Nested.Tags)
+	})
+
+	t.Run("reads values including nested structs", func(t *testing.T) {
+		var cfg loaderTestConfig
+		err := Load(&cfg, lookupFrom(map[string]string{
+			"TEST_NAME":     "blog",
+			"TEST_ENABLED":  "yes",
+			"TEST_WORKERS":  "8",
+			"TEST_RATIO":    "0.75",
+			"TEST_MODE":     "slow",
+			"TEST_SECRET":   "s3cret",
+			"TEST_TIMEOUT":  "1m30s",
+			"TEST_TAGS":     "go, web,,templates ",
+			"TEST_INTERNAL": "ignored",
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "blog", cfg.Name)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, 8, cfg.Workers)
+		assert.Equal(t, 0.75, cfg.Ratio)
+		assert.Equal(t, "slow", cfg.Mode)
+		assert.Equal(t, "s3cret", cfg.Secret)
+		assert.Equal(t, 90*time.Second, cfg.Nested.Timeout)
+		assert.Equal(t, []string{"go", "web", "templates"}, cfg.Nested.Tags)
+		assert.Empty(t, cfg.internal)
+	})
+
+	t.Run("treats empty values as unset", func(t *testing.T) {
+		var cfg loaderTestConfig
+		err := Load(&cfg, lookupFrom(map[string]string{"TEST_SECRET": "s3cret", "TEST_WORKERS": ""}))
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, cfg.Workers)
+	})
+
+	t.Run("reports every malformed value and missing field", func(t *testing.T) {
+		var cfg loaderTestConfig
+		err := Load(&cfg, lookupFrom(map[string]string{
+			"TEST_ENABLED": "maybe",
+			"TEST_WORKERS": "many",
+			"TEST_MODE":    "medium",
+			"TEST_TIMEOUT": "soon",
+		}))
+
+		var loadErr *LoadError
+		require.True(t, errors.As(err, &loadErr))
+		assert.Equal(t, []string{"TEST_SECRET"}, loadErr.Missing)
+
+		envs := make([]string, 0, len(loadErr.Invalid))
+		for _, invalid := range loadErr.Invalid {
+			envs = append(envs, invalid.Env)
+		}
+		assert.Equal(t, []string{"TEST_ENABLED", "TEST_WORKERS", "TEST_MODE", "TEST_TIMEOUT"}, envs)
+
+		message := err.Error()
+		assert.Contains(t, message, `TEST_WORKERS: invalid value "many" for Workers: expected an integer`)
+		assert.Contains(t, message, `TEST_MODE: invalid value "medium" for Mode: must be one of fast, slow`)
+		assert.Contains(t, message, `for Nested.Timeout`)
+		assert.Contains(t, message, "missing required settings: TEST_SECRET")
+	})
+
+	t.Run("rejects non-struct targets", func(t *testing.T) {
+		var cfg loaderTestConfig
+		assert.Error(t, Load(cfg, lookupFrom(nil)))
+	})
+}
+
+func TestLoadSettings(t *testing.T) {
+	var settings Settings
+	err := Load(&settings, lookupFrom(map[string]string{
+		"PORT":             "9090",
+		"IS_DEVELOPMENT":   "false",
+		"GITHUB_OWNER":     "benidevo",
+		"GITHUB_CACHE_TTL": "1m",
+		"CONTENT_SOURCE":   ContentSourceFilesystem,
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "9090", settings.Port)
+	assert.False(t, settings.IsDevelopment)
+	assert.Equal(t, "info", settings.LogLevel)
+	assert.Equal(t, "benidevo", settings.GitHub.Owner)
+	assert.Equal(t, "https://api.github.com", settings.GitHub.BaseURL)
+	assert.Equal(t, time.Minute, settings.GitHub.CacheTTL)
+	assert.Equal(t, 24*time.Hour, settings.GitHub.CacheMaxStale)
+	assert.Equal(t, ContentSourceFilesystem, settings.Content.Source)
+	assert.Equal(t, "./content", settings.Content.Directory)
+}
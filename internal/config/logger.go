@@ -31,4 +31,7 @@ func InitializeLogger(isDevelopment bool, logLevel string) {
 		Timestamp().
 		Caller().
 		Logger()
+
+	// Contexts without a request logger fall back to the application logger
+	zerolog.DefaultContextLogger = &log.Logger
 }
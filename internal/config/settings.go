@@ -1,48 +1,93 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
-
-	"github.com/joho/godotenv"
-	"github.com/rs/zerolog/log"
+	"time"
 )
 
 type Settings struct {
-	Port          string       `json:"port" env:"PORT" default:"8080"`
-	IsDevelopment bool         `json:"is_development" env:"IS_DEVELOPMENT" default:"true"`
-	LogLevel      string       `json:"log_level" env:"LOG_LEVEL" default:"info"`
-	GitHub        GitHubConfig `json:"github"`
+	Port          string        `json:"port" env:"PORT" default:"8080"`
+	IsDevelopment bool          `json:"is_development" env:"IS_DEVELOPMENT" default:"true"`
+	LogLevel      string        `json:"log_level" env:"LOG_LEVEL" default:"info"`
+	GitHub        GitHubConfig  `json:"github"`
+	Content       ContentConfig `json:"content"`
+	Metrics       MetricsConfig `json:"metrics"`
+	Tracing       TracingConfig `json:"tracing"`
 }
 
 type GitHubConfig struct {
-	Owner      string `json:"owner" env:"GITHUB_OWNER"`
-	Repository string `json:"repository" env:"GITHUB_REPOSITORY"`
-	Token      string `json:"token" env:"GITHUB_TOKEN"`
-	BaseURL    string `json:"base_url" env:"GITHUB_BASE_URL" default:"https://api.github.com"`
+	Owner         string        `json:"owner" env:"GITHUB_OWNER"`
+	Repository    string        `json:"repository" env:"GITHUB_REPOSITORY"`
+	Token         string        `json:"token" env:"GITHUB_TOKEN"`
+	BaseURL       string        `json:"base_url" env:"GITHUB_BASE_URL" default:"https://api.github.com"`
+	WebhookSecret string        `json:"webhook_secret" env:"GITHUB_WEBHOOK_SECRET"`
+	CacheTTL      time.Duration `json:"cache_ttl" env:"GITHUB_CACHE_TTL" default:"15m"`
+	CacheMaxStale time.Duration `json:"cache_max_stale" env:"GITHUB_CACHE_MAX_STALE" default:"24h"`
 }
 
-func NewSettings() *Settings {
-	if err := godotenv.Load(); err != nil {
-		log.Debug().Err(err).Msg("No .env file found... \nusing environment variables only")
-	}
+// Supported values for ContentConfig.Source
+const (
+	ContentSourceAuto       = "auto"
+	ContentSourceGitHub     = "github"
+	ContentSourceFilesystem = "filesystem"
+	ContentSourceMemory     = "memory"
+)
 
-	return &Settings{
-		Port:          getEnv("PORT", "8080"),
-		IsDevelopment: getEnv("IS_DEVELOPMENT", "true") == "true",
-		LogLevel:      getEnv("LOG_LEVEL", "info"),
-		GitHub: GitHubConfig{
-			Owner:      getEnv("GITHUB_OWNER", ""),
-			Repository: getEnv("GITHUB_REPOSITORY", ""),
-			Token:      getEnv("GITHUB_TOKEN", ""),
-			BaseURL:    getEnv("GITHUB_BASE_URL", "https://api.github.com"),
-		},
-	}
+type ContentConfig struct {
+	Source    string `json:"source" env:"CONTENT_SOURCE" default:"auto" options:"auto,github,filesystem,memory"`
+	Directory string `json:"directory" env:"CONTENT_DIR" default:"./content"`
+}
+
+type MetricsConfig struct {
+	// Token, when set, must be sent as a bearer token to read /metrics
+	Token string `json:"token" env:"METRICS_TOKEN"`
+}
+
+// Supported values for TracingConfig.Exporter
+const (
+	TracingExporterAuto   = "auto"
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+	TracingExporterNone   = "none"
+)
+
+type TracingConfig struct {
+	Exporter    string  `json:"exporter" env:"TRACING_EXPORTER" default:"auto" options:"auto,otlp,stdout,none"`
+	Endpoint    string  `json:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	ServiceName string  `json:"service_name" env:"OTEL_SERVICE_NAME" default:"website"`
+	SampleRatio float64 `json:"sample_ratio" env:"TRACING_SAMPLE_RATIO" default:"1"`
 }
 
-func getEnv(key string, defaultValue string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		return defaultValue
+// NewSettings loads settings using the struct tags above. Values come from the
+// environment, then the optional YAML or TOML config
+// file, then the defaults. A *LoadError also lists the validation problems with the
+// settings that did load, so one run reports everything that needs fixing.
+func NewSettings(configFile string) (*Settings, error) {
+	settings := &Settings{}
+	lookup := LookupFunc(os.LookupEnv)
+
+	if configFile != "" {
+		values, err := readConfigFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		fromFile, err := fileLookup(settings, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", configFile, err)
+		}
+		lookup = layeredLookup(lookup, fromFile)
 	}
-	return value
+
+	if err := Load(settings, lookup); err != nil {
+		var loadErr *LoadError
+		if errors.As(err, &loadErr) {
+			// Report what is wrong with the rest of the settings in the same error
+			loadErr.Problems = settings.validationProblems(loadErr)
+		}
+		return nil, err
+	}
+
+	return settings, nil
 }
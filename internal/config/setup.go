@@ -1,20 +1,27 @@
 package config
 
-import (
-	"github.com/joho/godotenv"
-)
+import "os"
 
 // Config bundles all configuration for the application
 type Config struct {
 	Settings *Settings
 }
 
-// SetupConfig initializes and returns application configuration
-func SetupConfig() (*Config, error) {
-	// Load environment variables from .env file (ignore error if file doesn't exist)
-	_ = godotenv.Load()
+// SetupConfig initializes and returns application configuration. configFile optionally
+// names a YAML or TOML file to read settings from; when empty, CONFIG_FILE is used.
+func SetupConfig(configFile string) (*Config, error) {
+	if configFile == "" {
+		configFile = os.Getenv("CONFIG_FILE")
+	}
 
-	settings := NewSettings()
+	settings, err := NewSettings(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
 
 	InitializeLogger(settings.IsDevelopment, settings.LogLevel)
 
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Problem is an unusable setting, keyed by the environment variables involved
+type Problem struct {
+	Env     []string // Variables whose values cause the problem
+	Message string
+}
+
+// ValidationError lists every problem found in a set of settings
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(problemMessages(e.Problems), "\n  ")
+}
+
+// problemMessages returns the message of each problem
+func problemMessages(problems []Problem) []string {
+	messages := make([]string, len(problems))
+	for i, problem := range problems {
+		messages[i] = problem.Message
+	}
+	return messages
+}
+
+// Validate checks that the settings are consistent and usable, returning a
+// *ValidationError describing every problem found
+func (s *Settings) Validate() error {
+	var problems []Problem
+
+	if port, err := strconv.Atoi(s.Port); err != nil || port < 1 || port > 65535 {
+		problems = append(problems, Problem{
+			Env:     []string{"PORT"},
+			Message: fmt.Sprintf("PORT must be a number between 1 and 65535, got %q", s.Port),
+		})
+	}
+
+	if _, err := zerolog.ParseLevel(s.LogLevel); err != nil {
+		problems = append(problems, Problem{
+			Env:     []string{"LOG_LEVEL"},
+			Message: fmt.Sprintf("LOG_LEVEL %q is not a valid level (trace, debug, info, warn, error, fatal, panic, disabled)", s.LogLevel),
+		})
+	}
+
+	problems = append(problems, s.GitHub.validate(s.Content.Source == ContentSourceGitHub)...)
+	problems = append(problems, s.Content.validate()...)
+	problems = append(problems, s.Tracing.validate()...)
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// validationProblems validates partially loaded settings, leaving out the problems
+// involving a variable loadErr already reports, since those fields were never set
+func (s *Settings) validationProblems(loadErr *LoadError) []Problem {
+	var validationErr *ValidationError
+	if !errors.As(s.Validate(), &validationErr) {
+		return nil
+	}
+
+	var problems []Problem
+	for _, problem := range validationErr.Problems {
+		if !slices.ContainsFunc(problem.Env, loadErr.failed) {
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+// validate checks the GitHub settings. The owner and repository are required whenever
+// a token is configured or GitHub is explicitly selected as the content source.
+func (g *GitHubConfig) validate(required bool) []Problem {
+	var problems []Problem
+
+	if g.Token != "" || required {
+		var missing []string
+		if g.Token == "" {
+			missing = append(missing, "GITHUB_TOKEN")
+		}
+		if g.Owner == "" {
+			missing = append(missing, "GITHUB_OWNER")
+		}
+		if g.Repository == "" {
+			missing = append(missing, "GITHUB_REPOSITORY")
+		}
+		if len(missing) > 0 {
+			problems = append(problems, Problem{
+				Env:     missing,
+				Message: fmt.Sprintf("GitHub content requires %s to be set", strings.Join(missing, ", ")),
+			})
+		}
+	}
+
+	if baseURL, err := url.Parse(g.BaseURL); err != nil || baseURL.Scheme == "" || baseURL.Host == "" {
+		problems = append(problems, Problem{
+			Env:     []string{"GITHUB_BASE_URL"},
+			Message: fmt.Sprintf("GITHUB_BASE_URL must be an absolute URL, got %q", g.BaseURL),
+		})
+	}
+
+	if g.CacheTTL <= 0 {
+		problems = append(problems, Problem{
+			Env:     []string{"GITHUB_CACHE_TTL"},
+			Message: fmt.Sprintf("GITHUB_CACHE_TTL must be positive, got %s", g.CacheTTL),
+		})
+	}
+	if g.CacheMaxStale < 0 {
+		problems = append(problems, Problem{
+			Env:     []string{"GITHUB_CACHE_MAX_STALE"},
+			Message: fmt.Sprintf("GITHUB_CACHE_MAX_STALE must not be negative, got %s", g.CacheMaxStale),
+		})
+	}
+
+	return problems
+}
+
+// validate checks the content source settings
+func (c *ContentConfig) validate() []Problem {
+	switch c.Source {
+	case ContentSourceAuto, ContentSourceGitHub, ContentSourceMemory:
+	case ContentSourceFilesystem:
+		if c.Directory == "" {
+			return []Problem{{
+				Env:     []string{"CONTENT_DIR", "CONTENT_SOURCE"},
+				Message: "CONTENT_DIR must be set when CONTENT_SOURCE is filesystem",
+			}}
+		}
+	default:
+		return []Problem{{
+			Env:     []string{"CONTENT_SOURCE"},
+			Message: fmt.Sprintf("CONTENT_SOURCE %q is not supported (auto, github, filesystem, memory)", c.Source),
+		}}
+	}
+	return nil
+}
+
+// validate checks the tracing settings
+func (t *TracingConfig) validate() []Problem {
+	var problems []Problem
+
+	switch t.Exporter {
+	case TracingExporterAuto, TracingExporterStdout, TracingExporterNone:
+	case TracingExporterOTLP:
+		if t.Endpoint == "" {
+			problems = append(problems, Problem{
+				Env:     []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "TRACING_EXPORTER"},
+				Message: "OTEL_EXPORTER_OTLP_ENDPOINT must be set when TRACING_EXPORTER is otlp",
+			})
+		}
+	default:
+		problems = append(problems, Problem{
+			Env:     []string{"TRACING_EXPORTER"},
+			Message: fmt.Sprintf("TRACING_EXPORTER %q is not supported (auto, otlp, stdout, none)", t.Exporter),
+		})
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		problems = append(problems, Problem{
+			Env:     []string{"TRACING_SAMPLE_RATIO"},
+			Message: fmt.Sprintf("TRACING_SAMPLE_RATIO must be between 0 and 1, got %g", t.SampleRatio),
+		})
+	}
+
+	return problems
+}
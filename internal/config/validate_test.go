@@ -0,0 +1,193 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validSettings() *Settings {
+	return &Settings{
+		Port:     "8080",
+		LogLevel: "info",
+		GitHub: GitHubConfig{
+			BaseURL:       "https://api.github.com",
+			CacheTTL:      15 * time.Minute,
+			CacheMaxStale: 24 * time.Hour,
+		},
+		Content: ContentConfig{
+			Source:    ContentSourceAuto,
+			Directory: "./content",
+		},
+		Tracing: TracingConfig{
+			Exporter:    TracingExporterAuto,
+			ServiceName: "website",
+			SampleRatio: 1,
+		},
+	}
+}
+
+func TestSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(s *Settings)
+		problems []string
+	}{
+		{
+			name:   "valid defaults",
+			modify: func(s *Settings) {},
+		},
+		{
+			name: "complete GitHub settings",
+			modify: func(s *Settings) {
+				s.GitHub.Token = "token"
+				s.GitHub.Owner = "benidevo"
+				s.GitHub.Repository = "content"
+				s.Content.Source = ContentSourceGitHub
+			},
+		},
+		{
+			name:     "non-numeric port",
+			modify:   func(s *Settings) { s.Port = "http" },
+			problems: []string{`PORT must be a number between 1 and 65535, got "http"`},
+		},
+		{
+			name:     "port zero",
+			modify:   func(s *Settings) { s.Port = "0" },
+			problems: []string{`PORT must be a number between 1 and 65535, got "0"`},
+		},
+		{
+			name:     "port out of range",
+			modify:   func(s *Settings) { s.Port = "70000" },
+			problems: []string{`PORT must be a number between 1 and 65535, got "70000"`},
+		},
+		{
+			name:     "unknown log level",
+			modify:   func(s *Settings) { s.LogLevel = "verbose" },
+			problems: []string{`LOG_LEVEL "verbose" is not a valid level (trace, debug, info, warn, error, fatal, panic, disabled)`},
+		},
+		{
+			name:     "token without owner and repository",
+			modify:   func(s *Settings) { s.GitHub.Token = "token" },
+			problems: []string{"GitHub content requires GITHUB_OWNER, GITHUB_REPOSITORY to be set"},
+		},
+		{
+			name:     "github source without token",
+			modify:   func(s *Settings) { s.Content.Source = ContentSourceGitHub },
+			problems: []string{"GitHub content requires GITHUB_TOKEN, GITHUB_OWNER, GITHUB_REPOSITORY to be set"},
+		},
+		{
+			name:     "relative base URL",
+			modify:   func(s *Settings) { s.GitHub.BaseURL = "api.github.com" },
+			problems: []string{`GITHUB_BASE_URL must be an absolute URL, got "api.github.com"`},
+		},
+		{
+			name:     "non-positive cache TTL",
+			modify:   func(s *Settings) { s.GitHub.CacheTTL = 0 },
+			problems: []string{"GITHUB_CACHE_TTL must be positive, got 0s"},
+		},
+		{
+			name: "filesystem source without directory",
+			modify: func(s *Settings) {
+				s.Content.Source = ContentSourceFilesystem
+				s.Content.Directory = ""
+			},
+			problems: []string{"CONTENT_DIR must be set when CONTENT_SOURCE is filesystem"},
+		},
+		{
+			name:     "otlp exporter without endpoint",
+			modify:   func(s *Settings) { s.Tracing.Exporter = TracingExporterOTLP },
+			problems: []string{"OTEL_EXPORTER_OTLP_ENDPOINT must be set when TRACING_EXPORTER is otlp"},
+		},
+		{
+			name:     "sample ratio out of range",
+			modify:   func(s *Settings) { s.Tracing.SampleRatio = 1.5 },
+			problems: []string{"TRACING_SAMPLE_RATIO must be between 0 and 1, got 1.5"},
+		},
+		{
+			name: "aggregates every problem",
+			modify: func(s *Settings) {
+				s.Port = "abc"
+				s.GitHub.Token = "token"
+				s.Content.Source = "database"
+			},
+			problems: []string{
+				`PORT must be a number between 1 and 65535, got "abc"`,
+				"GitHub content requires GITHUB_OWNER, GITHUB_REPOSITORY to be set",
+				`CONTENT_SOURCE "database" is not supported (auto, github, filesystem, memory)`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := validSettings()
+			tt.modify(settings)
+
+			err := settings.Validate()
+			if tt.problems == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.problems, problemMessages(validationErr.Problems))
+		})
+	}
+}
+
+func TestValidateKeysProblemsByVariable(t *testing.T) {
+	settings := validSettings()
+	settings.GitHub.Token = "token"
+	settings.Tracing.Exporter = TracingExporterOTLP
+
+	var validationErr *ValidationError
+	require.True(t, errors.As(settings.Validate(), &validationErr))
+
+	keys := make([][]string, len(validationErr.Problems))
+	for i, problem := range validationErr.Problems {
+		keys[i] = problem.Env
+	}
+	assert.Equal(t, [][]string{
+		{"GITHUB_OWNER", "GITHUB_REPOSITORY"},
+		{"OTEL_EXPORTER_OTLP_ENDPOINT", "TRACING_EXPORTER"},
+	}, keys)
+}
+
+func TestSetupConfigFailsFast(t *testing.T) {
+	t.Setenv("PORT", "not-a-port")
+	t.Setenv("GITHUB_TOKEN", "token")
+	t.Setenv("GITHUB_OWNER", "")
+	t.Setenv("GITHUB_REPOSITORY", "")
+	t.Setenv("CONFIG_FILE", "")
+
+	cfg, err := SetupConfig("")
+
+	assert.Nil(t, cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "PORT must be a number")
+	assert.Contains(t, err.Error(), "GITHUB_OWNER, GITHUB_REPOSITORY")
+}
+
+func TestSetupConfigAggregatesLoadAndValidationErrors(t *testing.T) {
+	t.Setenv("PORT", "not-a-port")
+	t.Setenv("GITHUB_CACHE_TTL", "soon")
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("CONFIG_FILE", "")
+
+	cfg, err := SetupConfig("")
+
+	assert.Nil(t, cfg)
+	var loadErr *LoadError
+	require.True(t, errors.As(err, &loadErr))
+	require.Len(t, loadErr.Invalid, 1)
+	assert.Equal(t, "GITHUB_CACHE_TTL", loadErr.Invalid[0].Env)
+	assert.Equal(t, []string{`PORT must be a number between 1 and 65535, got "not-a-port"`}, problemMessages(loadErr.Problems),
+		"problems with fields that failed to load are not repeated")
+	assert.Contains(t, err.Error(), "GITHUB_CACHE_TTL: invalid value")
+	assert.Contains(t, err.Error(), "PORT must be a number")
+}
@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/client"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/requestid"
+	"github.com/benidevo/website/internal/services"
+)
+
+// apiPathPrefix is the path prefix of every JSON API route
+const apiPathPrefix = "/api/"
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// APIHandler serves the read-only JSON API
+type APIHandler struct {
+	projectService    *services.ProjectService
+	technologyService *services.TechnologyService
+}
+
+// NewAPIHandler creates a new API handler
+func NewAPIHandler(projectService *services.ProjectService, technologyService *services.TechnologyService) *APIHandler {
+	return &APIHandler{
+		projectService:    projectService,
+		technologyService: technologyService,
+	}
+}
+
+// ListProjects returns a page of projects, optionally filtered by the technology,
+// language and featured query parameters
+func (h *APIHandler) ListProjects(c *gin.Context) {
+	page, perPage, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	filter := services.ProjectFilter{
+		Technology: c.Query("technology"),
+		Language:   c.Query("language"),
+	}
+	if value, set := c.GetQuery("featured"); set {
+		featured, err := strconv.ParseBool(value)
+		if err != nil {
+			writeAPIError(c, http.StatusBadRequest, models.ErrorCodeInvalidParameter,
+				fmt.Sprintf("featured must be true or false, got %q", value))
+			return
+		}
+		filter.Featured = &featured
+	}
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	projects, err := h.projectService.ListProjects(ctx, filter)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	data, pagination := paginate(projects, page, perPage)
+	c.JSON(http.StatusOK, models.ProjectList{Data: data, Pagination: pagination})
+}
+
+// GetProject returns a single project by its numeric ID
+func (h *APIHandler) GetProject(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		writeAPIError(c, http.StatusBadRequest, models.ErrorCodeInvalidParameter,
+			fmt.Sprintf("project id must be an integer, got %q", c.Param("id")))
+		return
+	}
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	project, err := h.projectService.GetProject(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrProjectNotFound) {
+			writeAPIError(c, http.StatusNotFound, models.ErrorCodeNotFound, fmt.Sprintf("project %d not found", id))
+			return
+		}
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ProjectResponse{Data: project})
+}
+
+// ListSkills returns a page of skill categories
+func (h *APIHandler) ListSkills(c *gin.Context) {
+	page, perPage, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	categories, err := h.projectService.ListSkillCategories(ctx)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	data, pagination := paginate(categories, page, perPage)
+	c.JSON(http.StatusOK, models.SkillCategoryList{Data: data, Pagination: pagination})
+}
+
+// ListTechnologies returns a page of technologies, sorted by name
+func (h *APIHandler) ListTechnologies(c *gin.Context) {
+	page, perPage, ok := parsePagination(c)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	technologies, err := h.technologyService.ListTechnologies(ctx)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	data, pagination := paginate(technologies, page, perPage)
+	c.JSON(http.StatusOK, models.TechnologyList{Data: data, Pagination: pagination})
+}
+
+// parsePagination reads the page and per_page query parameters, writing a 400 response
+// and returning ok=false when either is invalid
+func parsePagination(c *gin.Context) (page, perPage int, ok bool) {
+	page, perPage = 1, defaultPerPage
+
+	if value, set := c.GetQuery("page"); set {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			writeAPIError(c, http.StatusBadRequest, models.ErrorCodeInvalidParameter,
+				fmt.Sprintf("page must be a positive integer, got %q", value))
+			return 0, 0, false
+		}
+		page = n
+	}
+
+	if value, set := c.GetQuery("per_page"); set {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > maxPerPage {
+			writeAPIError(c, http.StatusBadRequest, models.ErrorCodeInvalidParameter,
+				fmt.Sprintf("per_page must be an integer between 1 and %d, got %q", maxPerPage, value))
+			return 0, 0, false
+		}
+		perPage = n
+	}
+
+	// Larger pages would overflow the offset of their first item
+	if page-1 > math.MaxInt/perPage {
+		writeAPIError(c, http.StatusBadRequest, models.ErrorCodeInvalidParameter,
+			fmt.Sprintf("page must be at most %d with per_page %d, got %d", math.MaxInt/perPage+1, perPage, page))
+		return 0, 0, false
+	}
+
+	return page, perPage, true
+}
+
+// paginate returns the requested page of items. Pages past the end are empty.
+func paginate[T any](items []T, page, perPage int) ([]T, models.Pagination) {
+	pagination := models.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		Total:      len(items),
+		TotalPages: (len(items) + perPage - 1) / perPage,
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return []T{}, pagination
+	}
+	end := min(start+perPage, len(items))
+	return items[start:end], pagination
+}
+
+// writeServiceError responds to a failure to load content: 503 while the content is
+// not available yet or GitHub is backing off, 500 for anything else
+func writeServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotReady), errors.Is(err, client.ErrRateLimited), errors.Is(err, context.DeadlineExceeded):
+		log.Ctx(c.Request.Context()).Warn().Err(err).Str("path", c.Request.URL.Path).Msg("API content unavailable")
+		writeAPIError(c, http.StatusServiceUnavailable, models.ErrorCodeUnavailable, "content is temporarily unavailable, try again shortly")
+	default:
+		log.Ctx(c.Request.Context()).Error().Err(err).Str("path", c.Request.URL.Path).Msg("API request failed")
+		writeAPIError(c, http.StatusInternalServerError, models.ErrorCodeInternal, "internal server error")
+	}
+}
+
+// writeAPIError aborts the request with a JSON error envelope
+func writeAPIError(c *gin.Context, status int, code, message string) {
+	c.AbortWithStatusJSON(status, models.ErrorResponse{
+		Error:     models.APIError{Code: code, Message: message},
+		RequestID: requestid.FromContext(c.Request.Context()),
+	})
+}
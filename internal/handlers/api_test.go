@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/services"
+)
+
+type stubProjectRepository struct {
+	projects []*models.Project
+	err      error
+}
+
+func (s *stubProjectRepository) GetAllProjects(ctx context.Context) ([]*models.Project, error) {
+	return s.projects, s.err
+}
+
+func (s *stubProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, project := range s.projects {
+		if project.Slug == slug {
+			return project, nil
+		}
+	}
+	return nil, repository.ErrProjectNotFound
+}
+
+type stubSkillRepository struct {
+	categories []models.SkillCategory
+	err        error
+}
+
+func (s *stubSkillRepository) GetSkillCategories(ctx context.Context) ([]models.SkillCategory, error) {
+	return s.categories, s.err
+}
+
+type stubTechnologyRepository struct {
+	technologies map[string]models.Technology
+	err          error
+}
+
+func (s *stubTechnologyRepository) GetTechnology(ctx context.Context, name string) (*models.Technology, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *stubTechnologyRepository) GetTechnologies(ctx context.Context, names []string) []models.Technology {
+	return nil
+}
+
+func (s *stubTechnologyRepository) GetAllTechnologies(ctx context.Context) (map[string]models.Technology, error) {
+	return s.technologies, s.err
+}
+
+// testAPIProjects has five projects so pagination can be exercised
+var testAPIProjects = []*models.Project{
+	{ID: 1, Title: "One", Language: "Go", Featured: true, Technologies: []models.Technology{{Name: "Docker"}}},
+	{ID: 2, Title: "Two", Language: "Go", Technologies: []models.Technology{{Name: "Redis"}}},
+	{ID: 3, Title: "Three", Language: "Python", Featured: true, Technologies: []models.Technology{{Name: "Docker"}}},
+	{ID: 4, Title: "Four", Language: "TypeScript", Technologies: []models.Technology{{Name: "React"}}},
+	{ID: 5, Title: "Five", Language: "Go", Featured: true, Technologies: []models.Technology{{Name: "PostgreSQL"}}},
+}
+
+func newAPITestRouter(projectRepo repository.ProjectRepository, skillRepo repository.SkillRepository, techRepo repository.TechnologyRepository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewAPIHandler(
+		services.NewProjectService(projectRepo, skillRepo),
+		services.NewTechnologyService(techRepo),
+	)
+	errorHandler := NewErrorHandler(services.NewProfileService(repository.NewInMemoryProfileRepository()))
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	api.GET("/projects", handler.ListProjects)
+	api.GET("/projects/:id", handler.GetProject)
+	api.GET("/skills", handler.ListSkills)
+	api.GET("/technologies", handler.ListTechnologies)
+	router.NoRoute(errorHandler.NotFound)
+	return router
+}
+
+func getJSON(t *testing.T, router *gin.Engine, path string, target interface{}) int {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	require.Contains(t, w.Header().Get("Content-Type"), "application/json")
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), target))
+	return w.Code
+}
+
+func TestAPIHandler_ListProjects(t *testing.T) {
+	router := newAPITestRouter(&stubProjectRepository{projects: testAPIProjects}, nil, nil)
+
+	tests := []struct {
+		name           string
+		query          string
+		wantIDs        []int
+		wantPagination models.Pagination
+	}{
+		{
+			name:           "defaults",
+			wantIDs:        []int{1, 2, 3, 4, 5},
+			wantPagination: models.Pagination{Page: 1, PerPage: 20, Total: 5, TotalPages: 1},
+		},
+		{
+			name:           "second page",
+			query:          "?page=2&per_page=2",
+			wantIDs:        []int{3, 4},
+			wantPagination: models.Pagination{Page: 2, PerPage: 2, Total: 5, TotalPages: 3},
+		},
+		{
+			name:           "page past the end",
+			query:          "?page=4&per_page=2",
+			wantIDs:        []int{},
+			wantPagination: models.Pagination{Page: 4, PerPage: 2, Total: 5, TotalPages: 3},
+		},
+		{
+			name:           "filter by language and featured",
+			query:          "?language=go&featured=true",
+			wantIDs:        []int{1, 5},
+			wantPagination: models.Pagination{Page: 1, PerPage: 20, Total: 2, TotalPages: 1},
+		},
+		{
+			name:           "filter by technology",
+			query:          "?technology=docker",
+			wantIDs:        []int{1, 3},
+			wantPagination: models.Pagination{Page: 1, PerPage: 20, Total: 2, TotalPages: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body models.ProjectList
+			status := getJSON(t, router, "/api/v1/projects"+tt.query, &body)
+
+			assert.Equal(t, http.StatusOK, status)
+			ids := make([]int, 0, len(body.Data))
+			for _, project := range body.Data {
+				ids = append(ids, project.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantPagination, body.Pagination)
+		})
+	}
+}
+
+func TestAPIHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectRepo *stubProjectRepository
+		path        string
+		wantStatus  int
+		wantCode    string
+	}{
+		{name: "invalid page", path: "/api/v1/projects?page=0", wantStatus: http.StatusBadRequest, wantCode: models.ErrorCodeInvalidParameter},
+		{name: "page too large", path: "/api/v1/projects?page=9223372036854775807", wantStatus: http.StatusBadRequest, wantCode: models.ErrorCodeInvalidParameter},
+		{name: "page too large for per_page", path: "/api/v1/projects?per_page=100&page=92233720368547760", wantStatus: http.StatusBadRequest, wantCode: models.ErrorCodeInvalidParameter},
+		{name: "per_page too large", path: "/api/v1/projects?per_page=101", wantStatus: http.StatusBadRequest, wantCode: models.ErrorCodeInvalidParameter},
+		{name: "invalid featured", path: "/api/v1/projects?featured=maybe", wantStatus: http.StatusBadRequest, wantCode: models.ErrorCodeInvalidParameter},
+		{name: "invalid id", path: "/api/v1/projects/abc", wantStatus: http.StatusBadRequest, wantCode: models.ErrorCodeInvalidParameter},
+		{name: "unknown project", path: "/api/v1/projects/99", wantStatus: http.StatusNotFound, wantCode: models.ErrorCodeNotFound},
+		{name: "unknown endpoint", path: "/api/v1/nothing", wantStatus: http.StatusNotFound, wantCode: models.ErrorCodeNotFound},
+		{
+			name:        "content not loaded",
+			projectRepo: &stubProjectRepository{err: repository.ErrNotReady},
+			path:        "/api/v1/projects",
+			wantStatus:  http.StatusServiceUnavailable,
+			wantCode:    models.ErrorCodeUnavailable,
+		},
+		{
+			name:        "repository failure",
+			projectRepo: &stubProjectRepository{err: errors.New("boom")},
+			path:        "/api/v1/projects/1",
+			wantStatus:  http.StatusInternalServerError,
+			wantCode:    models.ErrorCodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepo := tt.projectRepo
+			if projectRepo == nil {
+				projectRepo = &stubProjectRepository{projects: testAPIProjects}
+			}
+			router := newAPITestRouter(projectRepo, nil, nil)
+
+			var body models.ErrorResponse
+			status := getJSON(t, router, tt.path, &body)
+
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantCode, body.Error.Code)
+			assert.NotEmpty(t, body.Error.Message)
+		})
+	}
+}
+
+func TestAPIHandler_GetProject(t *testing.T) {
+	router := newAPITestRouter(&stubProjectRepository{projects: testAPIProjects}, nil, nil)
+
+	var body models.ProjectResponse
+	status := getJSON(t, router, "/api/v1/projects/3", &body)
+
+	assert.Equal(t, http.StatusOK, status)
+	require.NotNil(t, body.Data)
+	assert.Equal(t, "Three", body.Data.Title)
+}
+
+func TestAPIHandler_ListSkills(t *testing.T) {
+	router := newAPITestRouter(nil, &stubSkillRepository{categories: []models.SkillCategory{
+		{Category: "Backend", Skills: []models.Skill{{Name: "Go"}}},
+		{Category: "Frontend", Skills: []models.Skill{{Name: "React"}}},
+	}}, nil)
+
+	var body models.SkillCategoryList
+	status := getJSON(t, router, "/api/v1/skills?per_page=1", &body)
+
+	assert.Equal(t, http.StatusOK, status)
+	require.Len(t, body.Data, 1)
+	assert.Equal(t, "Backend", body.Data[0].Category)
+	assert.Equal(t, models.Pagination{Page: 1, PerPage: 1, Total: 2, TotalPages: 2}, body.Pagination)
+}
+
+func TestAPIHandler_ListTechnologies(t *testing.T) {
+	router := newAPITestRouter(nil, nil, &stubTechnologyRepository{technologies: map[string]models.Technology{
+		"redis": {Name: "Redis", Icon: "redis.svg"},
+		"go":    {Name: "Go", Icon: "go.svg"},
+	}})
+
+	var body models.TechnologyList
+	status := getJSON(t, router, "/api/v1/technologies", &body)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, []models.Technology{{Name: "Go", Icon: "go.svg"}, {Name: "Redis", Icon: "redis.svg"}}, body.Data)
+	assert.Equal(t, 2, body.Pagination.Total)
+}
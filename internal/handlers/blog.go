@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/services"
+)
+
+// BlogHandler handles blog related requests
+type BlogHandler struct {
+	postService    *services.PostService
+	profileService *services.ProfileService
+}
+
+// NewBlogHandler creates a new blog handler
+func NewBlogHandler(postService *services.PostService, profileService *services.ProfileService) *BlogHandler {
+	return &BlogHandler{
+		postService:    postService,
+		profileService: profileService,
+	}
+}
+
+// Index renders the list of published posts
+func (h *BlogHandler) Index(c *gin.Context) {
+	log.Ctx(c.Request.Context()).Info().Msg("Rendering blog index")
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	profile := h.profileService.GetProfile(ctx)
+
+	data := models.BlogPageData{
+		Title:        profile.PageTitle("Blog"),
+		Description:  profile.BlogDescription,
+		CanonicalURL: c.Request.URL.String(),
+		CurrentYear:  time.Now().Year(),
+		Profile:      profile,
+		Posts:        h.postService.GetPosts(ctx),
+	}
+
+	c.HTML(http.StatusOK, "blog", data)
+}
+
+// Show renders a single post by its slug
+func (h *BlogHandler) Show(c *gin.Context) {
+	slug := c.Param("slug")
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	profile := h.profileService.GetProfile(ctx)
+
+	post, err := h.postService.GetPost(ctx, slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrPostNotFound) {
+			renderErrorPage(c, http.StatusNotFound, profile)
+			return
+		}
+		log.Ctx(ctx).Error().Err(err).Str("slug", slug).Msg("Failed to get post")
+		_ = c.Error(err)
+		return
+	}
+
+	data := models.PostPageData{
+		Title:        profile.PageTitle(post.Title),
+		Description:  post.Summary,
+		CanonicalURL: c.Request.URL.String(),
+		CurrentYear:  time.Now().Year(),
+		Profile:      profile,
+		Post:         post,
+	}
+
+	c.HTML(http.StatusOK, "post", data)
+}
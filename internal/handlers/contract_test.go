@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/openapi"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/services"
+)
+
+// TestOpenAPIContract checks that every documented response the handlers produce
+// matches the schema in the OpenAPI document
+func TestOpenAPIContract(t *testing.T) {
+	loaded := &stubLoadTracker{ready: make(chan struct{})}
+	close(loaded.ready)
+
+	populated := newContractRouter(
+		&stubProjectRepository{projects: testAPIProjects},
+		&stubSkillRepository{categories: []models.SkillCategory{
+			{Category: "Backend", Skills: []models.Skill{{Name: "Go", Icon: "go.svg", Category: "Backend"}}},
+			{Category: "Empty"},
+		}},
+		&stubTechnologyRepository{technologies: map[string]models.Technology{"go": {Name: "Go", Icon: "go.svg"}}},
+		map[string]interface{}{"skills": loaded},
+	)
+	loading := newContractRouter(
+		&stubProjectRepository{err: repository.ErrNotReady},
+		&stubSkillRepository{err: repository.ErrNotReady},
+		&stubTechnologyRepository{err: errors.New("boom")},
+		map[string]interface{}{"skills": &stubLoadTracker{ready: make(chan struct{})}},
+	)
+
+	tests := []struct {
+		router     *gin.Engine
+		path       string // Documented path template
+		request    string
+		wantStatus int
+	}{
+		{populated, "/api/v1/projects", "/api/v1/projects?language=go", http.StatusOK},
+		{populated, "/api/v1/projects", "/api/v1/projects?page=9", http.StatusOK},
+		{populated, "/api/v1/projects", "/api/v1/projects?per_page=0", http.StatusBadRequest},
+		{loading, "/api/v1/projects", "/api/v1/projects", http.StatusServiceUnavailable},
+		{populated, "/api/v1/projects/{id}", "/api/v1/projects/1", http.StatusOK},
+		{populated, "/api/v1/projects/{id}", "/api/v1/projects/x", http.StatusBadRequest},
+		{populated, "/api/v1/projects/{id}", "/api/v1/projects/99", http.StatusNotFound},
+		{populated, "/api/v1/skills", "/api/v1/skills", http.StatusOK},
+		{loading, "/api/v1/skills", "/api/v1/skills", http.StatusServiceUnavailable},
+		{populated, "/api/v1/technologies", "/api/v1/technologies", http.StatusOK},
+		{loading, "/api/v1/technologies", "/api/v1/technologies", http.StatusInternalServerError},
+		{populated, "/api/v1/openapi.json", "/api/v1/openapi.json", http.StatusOK},
+		{populated, "/livez", "/livez", http.StatusOK},
+		{populated, "/health", "/health", http.StatusOK},
+		{populated, "/readyz", "/readyz", http.StatusOK},
+		{loading, "/readyz", "/readyz", http.StatusServiceUnavailable},
+	}
+
+	spec := openapi.Spec()
+	for _, tt := range tests {
+		t.Run(tt.request, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.request, nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.NoError(t, spec.ValidateResponse(http.MethodGet, tt.path, w.Code, w.Body.Bytes()))
+		})
+	}
+}
+
+func newContractRouter(
+	projectRepo repository.ProjectRepository,
+	skillRepo repository.SkillRepository,
+	techRepo repository.TechnologyRepository,
+	tracked map[string]interface{},
+) *gin.Engine {
+	router := newAPITestRouter(projectRepo, skillRepo, techRepo)
+
+	api := NewAPIHandler(nil, nil)
+	router.GET("/api/v1/openapi.json", api.OpenAPI)
+
+	health := NewHealthHandler(services.NewHealthService(config.ContentSourceMemory, "", nil, tracked))
+	router.GET("/livez", health.Livez)
+	router.GET("/health", health.Livez)
+	router.GET("/readyz", health.Readyz)
+
+	return router
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/requestid"
+	"github.com/benidevo/website/internal/services"
+)
+
+// ErrorHandler renders error pages for requests that no other handler serves
+type ErrorHandler struct {
+	profileService *services.ProfileService
+}
+
+// NewErrorHandler creates a new error handler
+func NewErrorHandler(profileService *services.ProfileService) *ErrorHandler {
+	return &ErrorHandler{
+		profileService: profileService,
+	}
+}
+
+// NotFound renders the 404 page, or a JSON error for API requests
+func (h *ErrorHandler) NotFound(c *gin.Context) {
+	if IsAPIRequest(c) {
+		writeAPIError(c, http.StatusNotFound, models.ErrorCodeNotFound, "no such endpoint")
+		return
+	}
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	renderErrorPage(c, http.StatusNotFound, h.profileService.GetProfile(ctx))
+}
+
+// IsAPIRequest reports whether the request is for a JSON API route, whose errors
+// use the JSON envelope whatever the Accept header says
+func IsAPIRequest(c *gin.Context) bool {
+	return strings.HasPrefix(c.Request.URL.Path, apiPathPrefix)
+}
+
+// renderErrorPage renders the template named after status ("404", "500") with the site profile
+func renderErrorPage(c *gin.Context, status int, profile *models.Profile) {
+	data := models.ErrorPageData{
+		Title:        profile.PageTitle(http.StatusText(status)),
+		Description:  profile.Description,
+		CanonicalURL: c.Request.URL.String(),
+		CurrentYear:  time.Now().Year(),
+		Profile:      profile,
+		RequestID:    requestid.FromContext(c.Request.Context()),
+	}
+
+	c.HTML(status, strconv.Itoa(status), data)
+}
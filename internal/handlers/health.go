@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/services"
+)
+
+// HealthHandler serves the liveness and readiness probes
+type HealthHandler struct {
+	healthService *services.HealthService
+}
+
+// NewHealthHandler creates a new health handler
+func NewHealthHandler(healthService *services.HealthService) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+	}
+}
+
+// Livez reports that the process is running. It never checks dependencies, so a slow or
+// unavailable content source does not get the instance restarted.
+func (h *HealthHandler) Livez(c *gin.Context) {
+	c.JSON(http.StatusOK, models.Liveness{
+		Status:    "ok",
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// Readyz reports whether the instance should receive traffic, responding 503 until
+// its content has loaded
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	readiness := h.healthService.Readiness()
+
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, readiness)
+}
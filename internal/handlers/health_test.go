@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/services"
+)
+
+type stubLoadTracker struct {
+	ready chan struct{}
+}
+
+func (s *stubLoadTracker) Ready() <-chan struct{} { return s.ready }
+func (s *stubLoadTracker) LoadedAt() time.Time    { return time.Now() }
+
+func TestHealthHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	skills := &stubLoadTracker{ready: make(chan struct{})}
+	handler := NewHealthHandler(services.NewHealthService(config.ContentSourceMemory, "", nil, map[string]interface{}{
+		"skills": skills,
+	}))
+
+	router := gin.New()
+	router.GET("/livez", handler.Livez)
+	router.GET("/readyz", handler.Readyz)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, get("/livez").Code, "live while content is loading")
+
+	w := get("/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var readiness models.Readiness
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &readiness))
+	assert.Equal(t, "not_ready", readiness.Status)
+	assert.Equal(t, models.CheckFailing, readiness.Checks["skills"].Status)
+
+	close(skills.ready)
+
+	w = get("/readyz")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &readiness))
+	assert.Equal(t, "ready", readiness.Status)
+	assert.Equal(t, models.CheckOK, readiness.Checks["skills"].Status)
+}
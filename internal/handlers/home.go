@@ -14,26 +14,39 @@ import (
 // HomeHandler handles home page related requests
 type HomeHandler struct {
 	projectService *services.ProjectService
+	profileService *services.ProfileService
 }
 
 // NewHomeHandler creates a new home handler
-func NewHomeHandler(projectService *services.ProjectService) *HomeHandler {
+func NewHomeHandler(projectService *services.ProjectService, profileService *services.ProfileService) *HomeHandler {
 	return &HomeHandler{
 		projectService: projectService,
+		profileService: profileService,
 	}
 }
 
 // HomePage renders the home page
 func (h *HomeHandler) HomePage(c *gin.Context) {
-	log.Info().Msg("Rendering home page")
+	log.Ctx(c.Request.Context()).Info().Msg("Rendering home page")
 
-	featuredProjects := h.projectService.GetFeaturedProjects()
-	skillCategories := h.projectService.GetSkillCategories()
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	profile := h.profileService.GetProfile(ctx)
+	featuredProjects := h.projectService.GetFeaturedProjects(ctx)
+	skillCategories := h.projectService.GetSkillCategories(ctx)
+
+	if c.Request.Context().Err() != nil {
+		log.Ctx(ctx).Debug().Msg("Client went away before home page was rendered")
+		return
+	}
 
 	data := models.HomePageData{
-		Description:      "Software Engineer specializing in Distributed Systems, Microservices, and Scalable Architecture.",
+		Title:            profile.SiteTitle(),
+		Description:      profile.Description,
 		CanonicalURL:     c.Request.URL.String(),
 		CurrentYear:      time.Now().Year(),
+		Profile:          profile,
 		FeaturedProjects: featuredProjects,
 		SkillCategories:  skillCategories,
 	}
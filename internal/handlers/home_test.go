@@ -50,6 +50,6 @@ func TestHomeHandler_HomePageDataAggregation(t *testing.T) {
 
 func TestHomeHandler_Creation(t *testing.T) {
 	// Test that handler can be created with a service
-	handler := NewHomeHandler(&services.ProjectService{})
+	handler := NewHomeHandler(&services.ProjectService{}, &services.ProfileService{})
 	assert.NotNil(t, handler)
 }
@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/benidevo/website/internal/openapi"
+)
+
+// OpenAPI serves the OpenAPI document describing the JSON API
+func (h *APIHandler) OpenAPI(c *gin.Context) {
+	c.JSON(http.StatusOK, openapi.Spec())
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/markdown"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/services"
+)
+
+// relatedProjectsLimit caps the related projects shown on a project page
+const relatedProjectsLimit = 3
+
+const (
+	// htmxRequestHeader is sent by HTMX on the requests it issues
+	htmxRequestHeader = "HX-Request"
+	// htmxHistoryRestoreHeader marks an HTMX request for a whole page missing from its
+	// history cache, sent when the user navigates back to a pushed URL
+	htmxHistoryRestoreHeader = "HX-History-Restore-Request"
+)
+
+// ProjectHandler handles project page requests
+type ProjectHandler struct {
+	projectService *services.ProjectService
+	profileService *services.ProfileService
+}
+
+// NewProjectHandler creates a new project handler
+func NewProjectHandler(projectService *services.ProjectService, profileService *services.ProfileService) *ProjectHandler {
+	return &ProjectHandler{
+		projectService: projectService,
+		profileService: profileService,
+	}
+}
+
+// Index renders every project, filtered by the technology, language and year query
+// parameters. HTMX requests receive only the list of matching project cards, except
+// history restores, which need the full page.
+func (h *ProjectHandler) Index(c *gin.Context) {
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	profile := h.profileService.GetProfile(ctx)
+
+	projects, err := h.projectService.ListProjects(ctx, services.ProjectFilter{})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to list projects")
+		_ = c.Error(err)
+		return
+	}
+
+	filter := services.ProjectFilter{
+		Technology: c.Query("technology"),
+		Language:   c.Query("language"),
+	}
+	// An unparseable year is ignored rather than rejected, like an unknown technology
+	filter.Year, _ = strconv.Atoi(c.Query("year"))
+
+	matched := make([]*models.Project, 0, len(projects))
+	for _, project := range projects {
+		if filter.Matches(project) {
+			matched = append(matched, project)
+		}
+	}
+
+	data := models.ProjectsPageData{
+		Title:              profile.PageTitle("Projects"),
+		Description:        profile.ProjectsDescription,
+		CanonicalURL:       c.Request.URL.String(),
+		CurrentYear:        time.Now().Year(),
+		Profile:            profile,
+		Projects:           matched,
+		Facets:             services.ProjectFacets(projects),
+		SelectedTechnology: filter.Technology,
+		SelectedLanguage:   filter.Language,
+		SelectedYear:       filter.Year,
+	}
+
+	// Caches must not serve the partial to a full page load, or the reverse
+	c.Header("Vary", htmxRequestHeader+", "+htmxHistoryRestoreHeader)
+	if c.GetHeader(htmxRequestHeader) == "true" && c.GetHeader(htmxHistoryRestoreHeader) != "true" {
+		c.HTML(http.StatusOK, "project_list", data)
+		return
+	}
+	c.HTML(http.StatusOK, "projects", data)
+}
+
+// Show renders a single project by its slug
+func (h *ProjectHandler) Show(c *gin.Context) {
+	slug := c.Param("slug")
+
+	ctx, cancel := requestContext(c)
+	defer cancel()
+
+	profile := h.profileService.GetProfile(ctx)
+
+	project, err := h.projectService.GetProjectBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, repository.ErrProjectNotFound) {
+			renderErrorPage(c, http.StatusNotFound, profile)
+			return
+		}
+		log.Ctx(ctx).Error().Err(err).Str("slug", slug).Msg("Failed to get project")
+		_ = c.Error(err)
+		return
+	}
+
+	data := models.ProjectPageData{
+		Title:           profile.PageTitle(project.Title),
+		Description:     markdown.Text(project.Description),
+		CanonicalURL:    c.Request.URL.String(),
+		CurrentYear:     time.Now().Year(),
+		Profile:         profile,
+		Project:         project,
+		RelatedProjects: h.projectService.RelatedProjects(ctx, project, relatedProjectsLimit),
+	}
+
+	c.HTML(http.StatusOK, "project", data)
+}
@@ -1,15 +1,44 @@
 package handlers
 
-import "github.com/benidevo/website/internal/services"
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/services"
+)
 
 // Handlers bundles all HTTP handlers
 type Handlers struct {
-	HomeHandler *HomeHandler
+	HomeHandler    *HomeHandler
+	BlogHandler    *BlogHandler
+	ProjectHandler *ProjectHandler
+	ErrorHandler   *ErrorHandler
+	WebhookHandler *WebhookHandler
+	HealthHandler  *HealthHandler
+	APIHandler     *APIHandler
 }
 
 // SetupHandlers initializes and returns all HTTP handlers with their service dependencies
-func SetupHandlers(services *services.Services) *Handlers {
+func SetupHandlers(cfg *config.Config, services *services.Services) *Handlers {
 	return &Handlers{
-		HomeHandler: NewHomeHandler(services.ProjectService),
+		HomeHandler:    NewHomeHandler(services.ProjectService, services.ProfileService),
+		BlogHandler:    NewBlogHandler(services.PostService, services.ProfileService),
+		ProjectHandler: NewProjectHandler(services.ProjectService, services.ProfileService),
+		ErrorHandler:   NewErrorHandler(services.ProfileService),
+		WebhookHandler: NewWebhookHandler(services.ContentService, cfg.Settings.GitHub.WebhookSecret),
+		HealthHandler:  NewHealthHandler(services.HealthService),
+		APIHandler:     NewAPIHandler(services.ProjectService, services.TechnologyService),
 	}
 }
+
+// dataTimeout bounds how long a request may spend loading data before rendering
+const dataTimeout = 10 * time.Second
+
+// requestContext returns the request's context with the data loading deadline applied,
+// so work stops as soon as the client disconnects or the deadline passes
+func requestContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(c.Request.Context(), dataTimeout)
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/services"
+)
+
+const (
+	signatureHeader   = "X-Hub-Signature-256"
+	eventHeader       = "X-GitHub-Event"
+	signaturePrefix   = "sha256="
+	maxWebhookPayload = 5 << 20 // GitHub caps webhook payloads at 25MB; content pushes are far smaller
+	refreshTimeout    = time.Minute
+)
+
+// WebhookHandler handles GitHub webhook deliveries
+type WebhookHandler struct {
+	contentService *services.ContentService
+	secret         []byte
+}
+
+// NewWebhookHandler creates a new webhook handler that verifies deliveries with the given secret
+func NewWebhookHandler(contentService *services.ContentService, secret string) *WebhookHandler {
+	return &WebhookHandler{
+		contentService: contentService,
+		secret:         []byte(secret),
+	}
+}
+
+// GitHub verifies the delivery signature and invalidates cached content touched by push events
+func (h *WebhookHandler) GitHub(c *gin.Context) {
+	if len(h.secret) == 0 {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "webhook secret not configured"})
+		return
+	}
+
+	// Read one byte past the limit to tell an oversized payload from one that fits exactly
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookPayload+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read payload"})
+		return
+	}
+	if len(body) > maxWebhookPayload {
+		log.Ctx(c.Request.Context()).Warn().Str("remote", c.ClientIP()).Msg("Rejected oversized webhook payload")
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "payload too large"})
+		return
+	}
+
+	if !h.validSignature(body, c.GetHeader(signatureHeader)) {
+		log.Ctx(c.Request.Context()).Warn().Str("remote", c.ClientIP()).Msg("Rejected webhook with invalid signature")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid signature"})
+		return
+	}
+
+	switch event := c.GetHeader(eventHeader); event {
+	case "ping":
+		c.JSON(http.StatusOK, gin.H{"status": "pong"})
+		return
+	case "push":
+	default:
+		c.JSON(http.StatusAccepted, gin.H{"status": "ignored", "event": event})
+		return
+	}
+
+	var push models.GitHubPushEvent
+	if err := json.Unmarshal(body, &push); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid push payload"})
+		return
+	}
+
+	if !services.IsDefaultBranchPush(&push) {
+		c.JSON(http.StatusAccepted, gin.H{"status": "ignored", "ref": push.Ref})
+		return
+	}
+
+	paths := services.ChangedPaths(&push)
+	invalidated := h.contentService.InvalidatePaths(paths)
+
+	log.Ctx(c.Request.Context()).Info().
+		Str("ref", push.Ref).
+		Int("paths", len(paths)).
+		Int("invalidated", invalidated).
+		Msg("Processed GitHub push webhook")
+
+	// Reload in the background so GitHub gets a response within its delivery timeout
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), refreshTimeout)
+	go func() {
+		defer cancel()
+		h.contentService.Refresh(refreshCtx, paths)
+	}()
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"status":      "accepted",
+		"paths":       paths,
+		"invalidated": invalidated,
+	})
+}
+
+// validSignature checks the X-Hub-Signature-256 header against an HMAC of the body
+func (h *WebhookHandler) validSignature(body []byte, header string) bool {
+	if !strings.HasPrefix(header, signaturePrefix) {
+		return false
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(header, signaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	return hmac.Equal(signature, mac.Sum(nil))
+}
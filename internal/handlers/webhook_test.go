@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/services"
+)
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhookHandler_GitHub(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const secret = "s3cret"
+	pushBody := `{"ref":"refs/heads/main","repository":{"default_branch":"main"},"commits":[{"modified":["skills/skills.json"]}]}`
+	largestPing := `{}` + strings.Repeat(" ", maxWebhookPayload-2)
+	oversizedPing := largestPing + " "
+
+	tests := []struct {
+		name       string
+		secret     string
+		event      string
+		body       string
+		signature  string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "rejects missing signature",
+			secret:     secret,
+			event:      "push",
+			body:       pushBody,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "rejects wrong signature",
+			secret:     secret,
+			event:      "push",
+			body:       pushBody,
+			signature:  sign("other", pushBody),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unavailable without secret",
+			event:      "push",
+			body:       pushBody,
+			signature:  sign(secret, pushBody),
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "answers ping",
+			secret:     secret,
+			event:      "ping",
+			body:       `{}`,
+			signature:  sign(secret, `{}`),
+			wantStatus: http.StatusOK,
+			wantBody:   "pong",
+		},
+		{
+			name:       "accepts a payload at the size limit",
+			secret:     secret,
+			event:      "ping",
+			body:       largestPing,
+			signature:  sign(secret, largestPing),
+			wantStatus: http.StatusOK,
+			wantBody:   "pong",
+		},
+		{
+			name:       "rejects an oversized payload",
+			secret:     secret,
+			event:      "ping",
+			body:       oversizedPing,
+			signature:  sign(secret, oversizedPing),
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody:   "payload too large",
+		},
+		{
+			name:       "ignores pushes to other branches",
+			secret:     secret,
+			event:      "push",
+			body:       `{"ref":"refs/heads/wip","repository":{"default_branch":"main"}}`,
+			signature:  sign(secret, `{"ref":"refs/heads/wip","repository":{"default_branch":"main"}}`),
+			wantStatus: http.StatusAccepted,
+			wantBody:   "ignored",
+		},
+		{
+			name:       "accepts signed push",
+			secret:     secret,
+			event:      "push",
+			body:       pushBody,
+			signature:  sign(secret, pushBody),
+			wantStatus: http.StatusAccepted,
+			wantBody:   "skills/skills.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			techRepo := repository.NewInMemoryTechnologyRepository()
+			contentService := services.NewContentService(
+				repository.NewInMemoryProjectRepository(techRepo),
+				techRepo,
+				repository.NewInMemorySkillRepository(techRepo),
+				repository.NewInMemoryPostRepository(),
+				repository.NewInMemoryProfileRepository(),
+				nil,
+			)
+			handler := NewWebhookHandler(contentService, tt.secret)
+
+			router := gin.New()
+			router.POST("/webhooks/github", handler.GitHub)
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(tt.body))
+			req.Header.Set("X-GitHub-Event", tt.event)
+			if tt.signature != "" {
+				req.Header.Set("X-Hub-Signature-256", tt.signature)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Package markdown renders untrusted Markdown to HTML that is safe to embed in templates
+package markdown
+
+import (
+	"html"
+	"html/template"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/russross/blackfriday/v2"
+)
+
+// textPolicy strips every element, keeping only text
+var textPolicy = bluemonday.StrictPolicy()
+
+// policy allows the formatting blackfriday produces for prose and nothing else: no
+// images, tables, classes, inline styles, event handlers or non-HTTP(S)/mailto links
+var policy = newPolicy()
+
+// postPolicy extends policy for long-form blog posts with images, tables and the
+// language class blackfriday puts on fenced code blocks
+var postPolicy = newPostPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	p.AllowElements(
+		"p", "br", "hr", "blockquote",
+		"h1", "h2", "h3", "h4", "h5", "h6",
+		"ul", "ol", "li",
+		"strong", "em", "del", "code", "pre",
+	)
+	p.AllowAttrs("start").Matching(regexp.MustCompile(`^[0-9]+$`)).OnElements("ol")
+
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowRelativeURLs(true)
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return p
+}
+
+func newPostPolicy() *bluemonday.Policy {
+	p := newPolicy()
+
+	p.AllowImages()
+	p.AllowTables()
+	p.AllowAttrs("title").Matching(bluemonday.Paragraph).OnElements("img")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9+#-]+$`)).OnElements("code")
+
+	return p
+}
+
+// Render converts Markdown to HTML with blackfriday's common extensions, then strips
+// every element and attribute the policy does not allow. Raw HTML in the source is
+// sanitized the same way rather than trusted.
+func Render(source string) template.HTML {
+	return render(source, policy)
+}
+
+// RenderPost converts a blog post's Markdown to HTML like Render, additionally
+// allowing images and tables
+func RenderPost(source string) template.HTML {
+	return render(source, postPolicy)
+}
+
+func render(source string, p *bluemonday.Policy) template.HTML {
+	if source == "" {
+		return ""
+	}
+	unsafe := blackfriday.Run([]byte(source))
+	return template.HTML(p.SanitizeBytes(unsafe))
+}
+
+// Text converts Markdown to plain text for places that cannot hold markup, such as
+// meta descriptions. The result is unescaped and must still be escaped on output.
+func Text(source string) string {
+	stripped := textPolicy.SanitizeBytes(blackfriday.Run([]byte(source)))
+	return strings.Join(strings.Fields(html.UnescapeString(string(stripped))), " ")
+}
@@ -0,0 +1,141 @@
+package markdown
+
+import (
+	"html/template"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   template.HTML
+	}{
+		{
+			name:   "empty",
+			source: "",
+			want:   "",
+		},
+		{
+			name:   "emphasis",
+			source: "A **fast** and *small* cache",
+			want:   "<p>A <strong>fast</strong> and <em>small</em> cache</p>\n",
+		},
+		{
+			name:   "external link",
+			source: "Built on [Redis](https://redis.io)",
+			want:   `<p>Built on <a href="https://redis.io" rel="nofollow noopener" target="_blank">Redis</a></p>` + "\n",
+		},
+		{
+			name:   "relative link",
+			source: "See [the blog](/blog)",
+			want:   `<p>See <a href="/blog" rel="nofollow">the blog</a></p>` + "\n",
+		},
+		{
+			name:   "lists and code",
+			source: "- `go test`\n- `go vet`",
+			want:   "<ul>\n<li><code>go test</code></li>\n<li><code>go vet</code></li>\n</ul>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Render(tt.source))
+		})
+	}
+}
+
+func TestRender_StripsXSSPayloads(t *testing.T) {
+	payloads := []string{
+		`<script>alert(1)</script>`,
+		`<img src=x onerror="alert(1)">`,
+		`<a href="javascript:alert(1)">click</a>`,
+		`[click](javascript:alert(1))`,
+		`[click](JaVaScRiPt:alert(1))`,
+		`[click](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)`,
+		`[click](vbscript:msgbox(1))`,
+		`<iframe src="https://evil.example"></iframe>`,
+		`<svg onload="alert(1)"></svg>`,
+		`<p style="background:url(javascript:alert(1))" onclick="alert(1)">text</p>`,
+		`<a href="https://example.com" onmouseover="alert(1)">link</a>`,
+		`<form action="https://evil.example"><input name="q"></form>`,
+		`<object data="evil.swf"></object>`,
+		`<style>body{display:none}</style>`,
+		`![x](https://evil.example/x.png"onerror="alert(1))`,
+		`<scr<script>ipt>alert(1)</script>`,
+	}
+
+	forbidden := []string{"<script", "javascript:", "vbscript:", "data:", "onerror", "onload", "onclick",
+		"onmouseover", "<iframe", "<svg", "style=", "<form", "<input", "<object", "<style", "<img"}
+
+	for _, payload := range payloads {
+		t.Run(payload, func(t *testing.T) {
+			rendered := strings.ToLower(string(Render(payload)))
+			for _, needle := range forbidden {
+				assert.NotContains(t, rendered, needle)
+			}
+		})
+	}
+}
+
+func TestRenderPost(t *testing.T) {
+	t.Run("keeps images and tables", func(t *testing.T) {
+		rendered := string(RenderPost("![Diagram](/static/images/diagram.png \"Flow\")\n\n| Cache | Hit rate |\n| --- | ---: |\n| Redis | 98% |"))
+
+		assert.Contains(t, rendered, `<img src="/static/images/diagram.png" alt="Diagram" title="Flow"/>`)
+		assert.Contains(t, rendered, "<table>")
+		assert.Contains(t, rendered, "<th>Cache</th>")
+		assert.Contains(t, rendered, `<td align="right">98%</td>`)
+	})
+
+	t.Run("keeps fenced code language", func(t *testing.T) {
+		rendered := string(RenderPost("```go\nfmt.Println(1)\n```"))
+
+		assert.Contains(t, rendered, `<code class="language-go">`)
+	})
+
+	t.Run("strips XSS payloads", func(t *testing.T) {
+		payloads := []string{
+			`<script>alert(1)</script>`,
+			`<img src=x onerror="alert(1)">`,
+			`<img src="javascript:alert(1)">`,
+			`![x](javascript:alert(1))`,
+			`<img src="data:image/svg+xml;base64,PHN2ZyBvbmxvYWQ9YWxlcnQoMSk+">`,
+			`<table onclick="alert(1)" style="color:red"><tr><td>x</td></tr></table>`,
+			`<code class="x onclick=alert(1)">x</code>`,
+			`[click](javascript:alert(1))`,
+			`<iframe src="https://evil.example"></iframe>`,
+			`<svg onload="alert(1)"></svg>`,
+		}
+		forbidden := []string{"<script", "javascript:", "data:", "onerror", "onload", "onclick", "style=", "<iframe", "<svg", `class="x`}
+
+		for _, payload := range payloads {
+			rendered := strings.ToLower(string(RenderPost(payload)))
+			for _, needle := range forbidden {
+				assert.NotContains(t, rendered, needle, payload)
+			}
+		}
+	})
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{name: "empty", source: "", want: ""},
+		{name: "strips formatting", source: "A **fast** cache, see [docs](https://example.com)", want: "A fast cache, see docs"},
+		{name: "joins paragraphs", source: "First.\n\nSecond & third.", want: "First. Second & third."},
+		{name: "drops markup", source: `Hi <img src=x onerror="alert(1)"><script>alert(1)</script>`, want: "Hi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Text(tt.source))
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Package metrics defines the Prometheus metrics exposed on /metrics
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "website"
+
+// Cache lookup results recorded by ObserveCacheLookup
+const (
+	CacheHit   = "hit"   // Fresh entry served from the cache
+	CacheStale = "stale" // Expired entry served while it is revalidated in the background
+	CacheMiss  = "miss"  // No usable entry, fetched from GitHub
+)
+
+// Upstream error reasons recorded by ObserveUpstreamError
+const (
+	UpstreamNetworkError = "network"
+	UpstreamRateLimited  = "rate_limited"
+	UpstreamStatusError  = "status"
+)
+
+// registry holds every collector served by Handler. A dedicated registry keeps
+// tests and other packages from leaking collectors into the output.
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled, by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency, by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	cacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "github_cache_lookups_total",
+		Help:      "GitHub client cache lookups, by result (hit, stale, miss).",
+	}, []string{"result"})
+
+	cacheEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "github_cache_evictions_total",
+		Help:      "GitHub client cache entries removed by invalidation.",
+	})
+
+	upstreamDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "github_request_duration_seconds",
+		Help:      "GitHub API request latency, by response status code (\"error\" when no response was received).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"status"})
+
+	upstreamErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "github_request_errors_total",
+		Help:      "Failed GitHub API requests, by reason (network, rate_limited, status).",
+	}, []string{"reason"})
+
+	repositoryLoaded = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "repository_last_load_timestamp_seconds",
+		Help:      "Unix time of the last successful content load, by repository.",
+	}, []string{"repository"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpRequestDuration,
+		cacheLookups,
+		cacheEvictions,
+		upstreamDuration,
+		upstreamErrors,
+		repositoryLoaded,
+	)
+}
+
+// Handler returns an HTTP handler serving every registered metric in the Prometheus text format
+func Handler() http.Handler {
+	// Responses are compressed by the router's gzip middleware
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{DisableCompression: true})
+}
+
+// ObserveHTTPRequest records a handled HTTP request
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpRequestDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// ObserveCacheLookup records the result of a GitHub client cache lookup
+func ObserveCacheLookup(result string) {
+	cacheLookups.WithLabelValues(result).Inc()
+}
+
+// ObserveCacheEvictions records cache entries removed from the GitHub client cache
+func ObserveCacheEvictions(count int) {
+	cacheEvictions.Add(float64(count))
+}
+
+// ObserveUpstreamRequest records the latency of a GitHub API request. status is 0
+// when the request failed before a response was received.
+func ObserveUpstreamRequest(status int, duration time.Duration) {
+	code := "error"
+	if status > 0 {
+		code = strconv.Itoa(status)
+	}
+	upstreamDuration.WithLabelValues(code).Observe(duration.Seconds())
+}
+
+// ObserveUpstreamError records a failed GitHub API request
+func ObserveUpstreamError(reason string) {
+	upstreamErrors.WithLabelValues(reason).Inc()
+}
+
+// RecordRepositoryLoad records that a repository loaded its content successfully at the given time
+func RecordRepositoryLoad(repository string, at time.Time) {
+	repositoryLoaded.WithLabelValues(repository).Set(float64(at.Unix()))
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservers(t *testing.T) {
+	t.Run("cache lookups", func(t *testing.T) {
+		before := testutil.ToFloat64(cacheLookups.WithLabelValues(CacheStale))
+		ObserveCacheLookup(CacheStale)
+		assert.Equal(t, before+1, testutil.ToFloat64(cacheLookups.WithLabelValues(CacheStale)))
+	})
+
+	t.Run("cache evictions", func(t *testing.T) {
+		before := testutil.ToFloat64(cacheEvictions)
+		ObserveCacheEvictions(3)
+		assert.Equal(t, before+3, testutil.ToFloat64(cacheEvictions))
+	})
+
+	t.Run("upstream requests without a response", func(t *testing.T) {
+		ObserveUpstreamRequest(0, 10*time.Millisecond)
+		assert.Equal(t, 1, testutil.CollectAndCount(upstreamDuration, "website_github_request_duration_seconds"))
+	})
+
+	t.Run("upstream errors", func(t *testing.T) {
+		before := testutil.ToFloat64(upstreamErrors.WithLabelValues(UpstreamRateLimited))
+		ObserveUpstreamError(UpstreamRateLimited)
+		assert.Equal(t, before+1, testutil.ToFloat64(upstreamErrors.WithLabelValues(UpstreamRateLimited)))
+	})
+
+	t.Run("repository loads", func(t *testing.T) {
+		loadedAt := time.Unix(1700000000, 0)
+		RecordRepositoryLoad("skills", loadedAt)
+		assert.Equal(t, float64(loadedAt.Unix()), testutil.ToFloat64(repositoryLoaded.WithLabelValues("skills")))
+	})
+}
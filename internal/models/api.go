@@ -0,0 +1,52 @@
+package models
+
+// Pagination describes the page of results returned by a list endpoint
+type Pagination struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"per_page"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
+
+// ProjectList is the response body of GET /api/v1/projects
+type ProjectList struct {
+	Data       []*Project `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// ProjectResponse is the response body of GET /api/v1/projects/:id
+type ProjectResponse struct {
+	Data *Project `json:"data"`
+}
+
+// SkillCategoryList is the response body of GET /api/v1/skills
+type SkillCategoryList struct {
+	Data       []SkillCategory `json:"data"`
+	Pagination Pagination      `json:"pagination"`
+}
+
+// TechnologyList is the response body of GET /api/v1/technologies
+type TechnologyList struct {
+	Data       []Technology `json:"data"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+// API error codes
+const (
+	ErrorCodeInvalidParameter = "invalid_parameter"
+	ErrorCodeNotFound         = "not_found"
+	ErrorCodeUnavailable      = "unavailable"
+	ErrorCodeInternal         = "internal_error"
+)
+
+// APIError describes why a JSON request failed
+type APIError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse is the body of every JSON error response
+type ErrorResponse struct {
+	Error     APIError `json:"error"`
+	RequestID string   `json:"request_id,omitempty"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Health check statuses reported by the readiness probe
+const (
+	CheckOK       = "ok"       // The dependency is working
+	CheckDegraded = "degraded" // The dependency is impaired but content can still be served
+	CheckFailing  = "failing"  // The dependency is unusable and the instance should not get traffic
+)
+
+// Liveness is the result of the liveness probe
+type Liveness struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// HealthCheck is the status of a single dependency
+type HealthCheck struct {
+	Status     string     `json:"status"`
+	Message    string     `json:"message,omitempty"`
+	LoadedAt   *time.Time `json:"loaded_at,omitempty"`
+	AgeSeconds *float64   `json:"age_seconds,omitempty"`
+	RateLimit  *RateLimit `json:"rate_limit,omitempty"`
+}
+
+// RateLimit is the GitHub API quota as last reported by GitHub
+type RateLimit struct {
+	Limit        int        `json:"limit"`
+	Remaining    int        `json:"remaining"`
+	Used         int        `json:"used"`
+	Reset        time.Time  `json:"reset"`
+	BlockedUntil *time.Time `json:"blocked_until,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// Readiness is the result of the readiness probe. The instance is ready when no check is failing.
+type Readiness struct {
+	Status    string                 `json:"status"`
+	Ready     bool                   `json:"ready"`
+	Checks    map[string]HealthCheck `json:"checks"`
+	Timestamp time.Time              `json:"timestamp"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"html/template"
+	"time"
+)
+
+// Post represents a blog post authored in Markdown
+type Post struct {
+	Slug        string        `json:"slug"`
+	Title       string        `json:"title"`
+	Summary     string        `json:"summary"`
+	PublishedAt time.Time     `json:"published_at"`
+	Tags        []string      `json:"tags"`
+	Draft       bool          `json:"draft"`
+	Content     string        `json:"content"`
+	HTML        template.HTML `json:"-"`
+}
+
+// PostFrontMatter represents the YAML front matter at the top of a post file
+type PostFrontMatter struct {
+	Title   string   `yaml:"title"`
+	Slug    string   `yaml:"slug"`
+	Summary string   `yaml:"summary"`
+	Date    string   `yaml:"date"`
+	Tags    []string `yaml:"tags"`
+	Draft   bool     `yaml:"draft"`
+}
+
+// BlogPageData represents all data needed for the blog index page
+type BlogPageData struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	CanonicalURL string   `json:"canonical_url"`
+	CurrentYear  int      `json:"current_year"`
+	Profile      *Profile `json:"profile"`
+	Posts        []*Post  `json:"posts"`
+}
+
+// PostPageData represents all data needed for a single blog post page
+type PostPageData struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	CanonicalURL string   `json:"canonical_url"`
+	CurrentYear  int      `json:"current_year"`
+	Profile      *Profile `json:"profile"`
+	Post         *Post    `json:"post"`
+}
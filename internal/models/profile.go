@@ -0,0 +1,80 @@
+package models
+
+import "strings"
+
+// Profile describes the person or team the site belongs to, as stored in profile/profile.json
+type Profile struct {
+	Name                string       `json:"name"`
+	Initials            string       `json:"initials,omitempty"`
+	Headline            string       `json:"headline"`
+	Tagline             string       `json:"tagline"`
+	Description         string       `json:"description"`
+	BlogDescription     string       `json:"blog_description,omitempty"`
+	ProjectsDescription string       `json:"projects_description,omitempty"`
+	Location            string       `json:"location,omitempty"`
+	Email               string       `json:"email,omitempty"`
+	Image               string       `json:"image,omitempty"`
+	About               []string     `json:"about"`
+	Social              SocialLinks  `json:"social"`
+	CoreTechnologies    []string     `json:"core_technologies"`
+	Contact             ContactPitch `json:"contact"`
+}
+
+// SocialLinks holds the profile's external accounts
+type SocialLinks struct {
+	GitHub   string `json:"github,omitempty"`
+	LinkedIn string `json:"linkedin,omitempty"`
+}
+
+// ContactPitch is the copy shown in the home page contact section
+type ContactPitch struct {
+	Heading string `json:"heading"`
+	Message string `json:"message"`
+}
+
+// Monogram returns the configured initials, or derives them from the first letters of the name
+func (p *Profile) Monogram() string {
+	if p.Initials != "" {
+		return p.Initials
+	}
+
+	var initials strings.Builder
+	for _, word := range strings.Fields(p.Name) {
+		initials.WriteString(strings.ToUpper(string([]rune(word)[:1])))
+		if initials.Len() >= 2 {
+			break
+		}
+	}
+	return initials.String()
+}
+
+// SiteTitle returns the default page title, e.g. "Jane Doe • Software Engineer"
+func (p *Profile) SiteTitle() string {
+	return joinTitle(p.Name, p.Headline)
+}
+
+// PageTitle suffixes a page title with the profile name, e.g. "Blog • Jane Doe"
+func (p *Profile) PageTitle(title string) string {
+	return joinTitle(title, p.Name)
+}
+
+// joinTitle joins the non-empty parts of a title with a bullet
+func joinTitle(parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, " • ")
+}
+
+// ErrorPageData represents all data needed for the 404 and 500 pages
+type ErrorPageData struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	CanonicalURL string   `json:"canonical_url"`
+	CurrentYear  int      `json:"current_year"`
+	Profile      *Profile `json:"profile"`
+	RequestID    string   `json:"request_id,omitempty"`
+}
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"html/template"
+	"strings"
+	"time"
+)
+
 // Technology represents a technology with its icon
 type Technology struct {
 	Name string `json:"name"`
@@ -8,14 +14,59 @@ type Technology struct {
 
 // Project represents a software project
 type Project struct {
-	ID           int          `json:"id"`
-	Title        string       `json:"title"`
-	Description  string       `json:"description"`
-	GitHubURL    string       `json:"github_url"`
-	LiveURL      string       `json:"live_url,omitempty"`
-	Language     string       `json:"language"`
-	Technologies []Technology `json:"technologies"`
-	Featured     bool         `json:"featured"`
+	ID              int            `json:"id"`
+	Slug            string         `json:"slug"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	LongDescription string         `json:"long_description,omitempty"`
+	GitHubURL       string         `json:"github_url"`
+	LiveURL         string         `json:"live_url,omitempty"`
+	Language        string         `json:"language"`
+	Technologies    []Technology   `json:"technologies"`
+	Featured        bool           `json:"featured"`
+	Images          []ProjectImage `json:"images,omitempty"`
+	StartedAt       *time.Time     `json:"started_at,omitempty"`
+	EndedAt         *time.Time     `json:"ended_at,omitempty"`
+
+	// Description and LongDescription rendered from Markdown and sanitized for templates
+	DescriptionHTML     template.HTML `json:"-"`
+	LongDescriptionHTML template.HTML `json:"-"`
+}
+
+// ProjectImage is a screenshot or other image shown on a project's page
+type ProjectImage struct {
+	URL     string `json:"url"`
+	Alt     string `json:"alt"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// UsesTechnology reports whether the project lists a technology with the given name, ignoring case
+func (p *Project) UsesTechnology(name string) bool {
+	for _, tech := range p.Technologies {
+		if strings.EqualFold(tech.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveYears returns every calendar year the project was worked on, oldest first. Projects
+// without an end date are treated as ongoing through currentYear; those without a start date have none.
+func (p *Project) ActiveYears(currentYear int) []int {
+	if p.StartedAt == nil {
+		return nil
+	}
+
+	last := currentYear
+	if p.EndedAt != nil {
+		last = p.EndedAt.Year()
+	}
+
+	var years []int
+	for year := p.StartedAt.Year(); year <= last; year++ {
+		years = append(years, year)
+	}
+	return years
 }
 
 // Skill represents a technical skill
@@ -37,20 +88,60 @@ type HomePageData struct {
 	Description      string          `json:"description"`
 	CanonicalURL     string          `json:"canonical_url"`
 	CurrentYear      int             `json:"current_year"`
+	Profile          *Profile        `json:"profile"`
 	FeaturedProjects []*Project      `json:"featured_projects"`
 	SkillCategories  []SkillCategory `json:"skill_categories"`
 }
 
-// ProjectData represents the JSON structure for a project in GitHub data files
-type ProjectData struct {
-	ID           int      `json:"id"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	GitHubURL    string   `json:"github_url"`
-	LiveURL      string   `json:"live_url"`
-	Language     string   `json:"language"`
+// ProjectsPageData represents all data needed for the projects index and its filtered results.
+// The selected filter values are empty, or zero for the year, when not filtering.
+type ProjectsPageData struct {
+	Title              string        `json:"title"`
+	Description        string        `json:"description"`
+	CanonicalURL       string        `json:"canonical_url"`
+	CurrentYear        int           `json:"current_year"`
+	Profile            *Profile      `json:"profile"`
+	Projects           []*Project    `json:"projects"`
+	Facets             ProjectFacets `json:"facets"`
+	SelectedTechnology string        `json:"selected_technology,omitempty"`
+	SelectedLanguage   string        `json:"selected_language,omitempty"`
+	SelectedYear       int           `json:"selected_year,omitempty"`
+}
+
+// ProjectFacets lists the values projects can be filtered by
+type ProjectFacets struct {
 	Technologies []string `json:"technologies"`
-	Featured     bool     `json:"featured"`
+	Languages    []string `json:"languages"`
+	Years        []int    `json:"years"`
+}
+
+// ProjectData represents the JSON structure for a project in GitHub data files.
+// Slug defaults to one derived from the title; dates are YYYY, YYYY-MM or YYYY-MM-DD.
+type ProjectData struct {
+	ID              int            `json:"id"`
+	Slug            string         `json:"slug,omitempty"`
+	Title           string         `json:"title"`
+	Description     string         `json:"description"`
+	LongDescription string         `json:"long_description,omitempty"`
+	GitHubURL       string         `json:"github_url"`
+	LiveURL         string         `json:"live_url"`
+	Language        string         `json:"language"`
+	Technologies    []string       `json:"technologies"`
+	Featured        bool           `json:"featured"`
+	Images          []ProjectImage `json:"images,omitempty"`
+	Started         string         `json:"started,omitempty"`
+	Ended           string         `json:"ended,omitempty"`
+}
+
+// ProjectPageData represents all data needed for a single project page
+type ProjectPageData struct {
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	CanonicalURL    string     `json:"canonical_url"`
+	CurrentYear     int        `json:"current_year"`
+	Profile         *Profile   `json:"profile"`
+	Project         *Project   `json:"project"`
+	RelatedProjects []*Project `json:"related_projects"`
 }
 
 // ProjectsResponse represents the structure of projects.json from GitHub
@@ -0,0 +1,22 @@
+package models
+
+// GitHubPushEvent represents the parts of a GitHub push webhook payload used for cache invalidation
+type GitHubPushEvent struct {
+	Ref        string                `json:"ref"`
+	Repository GitHubEventRepository `json:"repository"`
+	Commits    []GitHubCommit        `json:"commits"`
+}
+
+// GitHubEventRepository identifies the repository a webhook event was sent for
+type GitHubEventRepository struct {
+	FullName      string `json:"full_name"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+// GitHubCommit lists the files touched by a single commit in a push event
+type GitHubCommit struct {
+	ID       string   `json:"id"`
+	Added    []string `json:"added"`
+	Removed  []string `json:"removed"`
+	Modified []string `json:"modified"`
+}
@@ -0,0 +1,67 @@
+package openapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sampleChild struct {
+	Name string `json:"name"`
+}
+
+type sample struct {
+	ID        int            `json:"id"`
+	Title     string         `json:"title"`
+	Note      string         `json:"note,omitempty"`
+	Ratio     float64        `json:"ratio"`
+	Enabled   bool           `json:"enabled"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt *time.Time     `json:"updated_at,omitempty"`
+	Tags      []string       `json:"tags"`
+	Child     *sampleChild   `json:"child"`
+	Children  []sampleChild  `json:"children"`
+	Counts    map[string]int `json:"counts"`
+	Extra     interface{}    `json:"extra"`
+	Ignored   string         `json:"-"`
+	Untagged  string
+	internal  string                 // Unexported fields are not encoded
+	Nested    struct{ Value string } `json:"nested"`
+}
+
+func TestSchemaGenerator(t *testing.T) {
+	g := newSchemaGenerator()
+
+	ref := g.ref(sample{})
+	assert.Equal(t, "#/components/schemas/sample", ref.Ref)
+
+	schema := g.schemas["sample"]
+	require.NotNil(t, schema)
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, false, schema.AdditionalProperties)
+	assert.ElementsMatch(t, []string{
+		"id", "title", "ratio", "enabled", "created_at", "tags", "child",
+		"children", "counts", "extra", "Untagged", "nested",
+	}, schema.Required)
+
+	props := schema.Properties
+	assert.Len(t, props, 14)
+	assert.Equal(t, &Schema{Type: "integer"}, props["id"])
+	assert.Equal(t, &Schema{Type: "string"}, props["note"])
+	assert.Equal(t, &Schema{Type: "number"}, props["ratio"])
+	assert.Equal(t, &Schema{Type: "boolean"}, props["enabled"])
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, props["created_at"])
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, props["updated_at"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Type: "string"}, Nullable: true}, props["tags"])
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/sampleChild"}, props["child"])
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/sampleChild"}, props["children"].Items)
+	assert.Equal(t, &Schema{Type: "integer"}, props["counts"].AdditionalProperties)
+	assert.Equal(t, &Schema{}, props["extra"])
+	assert.Equal(t, "object", props["nested"].Type, "anonymous structs are inlined")
+	assert.NotContains(t, props, "Ignored")
+	assert.NotContains(t, props, "internal")
+
+	assert.Contains(t, g.schemas, "sampleChild")
+}
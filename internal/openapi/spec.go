@@ -0,0 +1,171 @@
+package openapi
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/benidevo/website/internal/models"
+)
+
+const jsonContentType = "application/json"
+
+// maxPerPage mirrors the page size limit enforced by the API handlers
+const maxPerPage = 100
+
+// spec is built once, on first use
+var spec = sync.OnceValue(buildSpec)
+
+// Spec returns the OpenAPI document describing the JSON API and the health probes.
+// Response schemas are generated from the models package types the handlers return.
+// The document is shared and must not be modified.
+func Spec() *Document {
+	return spec()
+}
+
+func buildSpec() *Document {
+	g := newSchemaGenerator()
+
+	errorResponses := func(statuses ...int) map[string]*Response {
+		responses := make(map[string]*Response, len(statuses))
+		for _, status := range statuses {
+			responses[strconv.Itoa(status)] = jsonResponse(errorDescriptions[status], g.ref(models.ErrorResponse{}))
+		}
+		return responses
+	}
+	withErrors := func(ok *Response, statuses ...int) map[string]*Response {
+		responses := errorResponses(statuses...)
+		responses["200"] = ok
+		return responses
+	}
+
+	pagination := []Parameter{
+		{
+			Name:        "page",
+			In:          "query",
+			Description: "Page number, starting at 1",
+			Schema:      &Schema{Type: "integer", Minimum: float(1), Default: 1},
+		},
+		{
+			Name:        "per_page",
+			In:          "query",
+			Description: "Number of items per page",
+			Schema:      &Schema{Type: "integer", Minimum: float(1), Maximum: float(maxPerPage), Default: 20},
+		},
+	}
+
+	readiness := g.ref(models.Readiness{})
+	liveness := jsonResponse("The process is running", g.ref(models.Liveness{}))
+
+	paths := map[string]*PathItem{
+		"/api/v1/projects": {Get: &Operation{
+			OperationID: "listProjects",
+			Summary:     "List projects",
+			Description: "Returns a page of projects, optionally filtered. Text filters ignore case.",
+			Tags:        []string{"projects"},
+			Parameters: append([]Parameter{
+				{Name: "technology", In: "query", Description: "Only projects using this technology", Schema: &Schema{Type: "string"}},
+				{Name: "language", In: "query", Description: "Only projects written in this language", Schema: &Schema{Type: "string"}},
+				{Name: "featured", In: "query", Description: "Only featured (true) or other (false) projects", Schema: &Schema{Type: "boolean"}},
+			}, pagination...),
+			Responses: withErrors(jsonResponse("A page of projects", g.ref(models.ProjectList{})),
+				http.StatusBadRequest, http.StatusInternalServerError, http.StatusServiceUnavailable),
+		}},
+		"/api/v1/projects/{id}": {Get: &Operation{
+			OperationID: "getProject",
+			Summary:     "Get a project",
+			Tags:        []string{"projects"},
+			Parameters: []Parameter{
+				{Name: "id", In: "path", Description: "Project ID", Required: true, Schema: &Schema{Type: "integer"}},
+			},
+			Responses: withErrors(jsonResponse("The project", g.ref(models.ProjectResponse{})),
+				http.StatusBadRequest, http.StatusNotFound, http.StatusInternalServerError, http.StatusServiceUnavailable),
+		}},
+		"/api/v1/skills": {Get: &Operation{
+			OperationID: "listSkills",
+			Summary:     "List skill categories",
+			Tags:        []string{"skills"},
+			Parameters:  pagination,
+			Responses: withErrors(jsonResponse("A page of skill categories", g.ref(models.SkillCategoryList{})),
+				http.StatusBadRequest, http.StatusInternalServerError, http.StatusServiceUnavailable),
+		}},
+		"/api/v1/technologies": {Get: &Operation{
+			OperationID: "listTechnologies",
+			Summary:     "List technologies",
+			Description: "Returns a page of technologies, sorted by name.",
+			Tags:        []string{"technologies"},
+			Parameters:  pagination,
+			Responses: withErrors(jsonResponse("A page of technologies", g.ref(models.TechnologyList{})),
+				http.StatusBadRequest, http.StatusInternalServerError, http.StatusServiceUnavailable),
+		}},
+		"/api/v1/openapi.json": {Get: &Operation{
+			OperationID: "getOpenAPI",
+			Summary:     "Get this OpenAPI document",
+			Tags:        []string{"meta"},
+			Responses: map[string]*Response{
+				"200": jsonResponse("The OpenAPI document", &Schema{Type: "object"}),
+			},
+		}},
+		"/livez": {Get: &Operation{
+			OperationID: "livez",
+			Summary:     "Liveness probe",
+			Description: "Succeeds while the process is running, without checking dependencies.",
+			Tags:        []string{"health"},
+			Responses:   map[string]*Response{"200": liveness},
+		}},
+		"/readyz": {Get: &Operation{
+			OperationID: "readyz",
+			Summary:     "Readiness probe",
+			Description: "Succeeds once content has loaded. Reports the status of each dependency either way.",
+			Tags:        []string{"health"},
+			Responses: map[string]*Response{
+				"200": jsonResponse("The instance is ready for traffic", readiness),
+				"503": jsonResponse("The instance is not ready for traffic", readiness),
+			},
+		}},
+		"/health": {Get: &Operation{
+			OperationID: "health",
+			Summary:     "Liveness probe (deprecated alias of /livez)",
+			Tags:        []string{"health"},
+			Responses:   map[string]*Response{"200": liveness},
+			Deprecated:  true,
+		}},
+	}
+
+	// ErrorResponse codes are a closed set, so list them for clients
+	g.schemas["APIError"].Properties["code"].Enum = []string{
+		models.ErrorCodeInvalidParameter,
+		models.ErrorCodeNotFound,
+		models.ErrorCodeUnavailable,
+		models.ErrorCodeInternal,
+	}
+
+	return &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "Website API",
+			Description: "Read-only access to the projects, skills and technologies shown on the site.",
+			Version:     "1.0.0",
+		},
+		Paths:      paths,
+		Components: Components{Schemas: g.schemas},
+	}
+}
+
+var errorDescriptions = map[int]string{
+	http.StatusBadRequest:          "A query or path parameter is invalid",
+	http.StatusNotFound:            "The resource does not exist",
+	http.StatusInternalServerError: "The request failed unexpectedly",
+	http.StatusServiceUnavailable:  "Content has not loaded yet or the content source is unavailable",
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content:     map[string]MediaType{jsonContentType: {Schema: schema}},
+	}
+}
+
+func float(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec(t *testing.T) {
+	doc := Spec()
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.NotEmpty(t, doc.Info.Title)
+
+	encoded, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	// Every reference in the document must resolve to a component
+	var refs []string
+	var collect func(value interface{})
+	collect = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				if ref, ok := child.(string); ok && key == "$ref" {
+					refs = append(refs, ref)
+				}
+				collect(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				collect(child)
+			}
+		}
+	}
+	var decoded interface{}
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	collect(decoded)
+
+	require.NotEmpty(t, refs)
+	for _, ref := range refs {
+		_, err := doc.resolve(ref)
+		assert.NoError(t, err, ref)
+	}
+
+	operationIDs := make(map[string]bool)
+	for path, item := range doc.Paths {
+		require.NotNil(t, item.Get, path)
+		assert.Contains(t, item.Get.Responses, "200", path)
+		assert.False(t, operationIDs[item.Get.OperationID], "duplicate operationId %s", item.Get.OperationID)
+		operationIDs[item.Get.OperationID] = true
+
+		for _, param := range item.Get.Parameters {
+			if param.In == "path" {
+				assert.True(t, param.Required, "%s: path parameter %s must be required", path, param.Name)
+				assert.Contains(t, path, "{"+param.Name+"}")
+			}
+		}
+		if strings.Contains(path, "{") {
+			assert.NotEmpty(t, item.Get.Parameters, path)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidateResponse checks a JSON response body against the schema the document declares
+// for the operation and status code, returning every mismatch found
+func (d *Document) ValidateResponse(method, path string, status int, body []byte) error {
+	op := d.Operation(method, path)
+	if op == nil {
+		return fmt.Errorf("%s %s is not documented", method, path)
+	}
+
+	response, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		return fmt.Errorf("%s %s does not document status %d", method, path, status)
+	}
+	media, ok := response.Content[jsonContentType]
+	if !ok {
+		return fmt.Errorf("%s %s status %d has no JSON body", method, path, status)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("response body is not valid JSON: %w", err)
+	}
+
+	var problems []error
+	d.validate(media.Schema, value, "$", &problems)
+	return errors.Join(problems...)
+}
+
+// validate appends a problem for every way value does not match schema
+func (d *Document) validate(schema *Schema, value interface{}, at string, problems *[]error) {
+	if schema.Ref != "" {
+		resolved, err := d.resolve(schema.Ref)
+		if err != nil {
+			*problems = append(*problems, fmt.Errorf("%s: %w", at, err))
+			return
+		}
+		d.validate(resolved, value, at, problems)
+		return
+	}
+
+	if value == nil {
+		if !schema.Nullable && schema.Type != "" {
+			*problems = append(*problems, fmt.Errorf("%s: null is not allowed, expected %s", at, schema.Type))
+		}
+		return
+	}
+
+	fail := func(format string, args ...interface{}) {
+		*problems = append(*problems, fmt.Errorf("%s: "+format, append([]interface{}{at}, args...)...))
+	}
+
+	switch schema.Type {
+	case "":
+		// An empty schema accepts any value
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			fail("expected object, got %s", jsonType(value))
+			return
+		}
+		d.validateObject(schema, object, at, problems)
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			fail("expected array, got %s", jsonType(value))
+			return
+		}
+		for i, item := range array {
+			d.validate(schema.Items, item, fmt.Sprintf("%s[%d]", at, i), problems)
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			fail("expected string, got %s", jsonType(value))
+			return
+		}
+		if schema.Format == "date-time" {
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				fail("expected date-time, got %q", s)
+			}
+		}
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, s) {
+			fail("%q is not one of %s", s, strings.Join(schema.Enum, ", "))
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			fail("expected integer, got %s", jsonType(value))
+			return
+		}
+		if _, err := n.Int64(); err != nil {
+			fail("expected integer, got %s", n)
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			fail("expected number, got %s", jsonType(value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("expected boolean, got %s", jsonType(value))
+		}
+	default:
+		fail("unsupported schema type %q", schema.Type)
+	}
+}
+
+// validateObject checks required, declared and undeclared properties of an object
+func (d *Document) validateObject(schema *Schema, object map[string]interface{}, at string, problems *[]error) {
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			*problems = append(*problems, fmt.Errorf("%s: missing required property %q", at, name))
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := at + "." + name
+		if property, ok := schema.Properties[name]; ok {
+			d.validate(property, object[name], path, problems)
+			continue
+		}
+		switch additional := schema.AdditionalProperties.(type) {
+		case *Schema:
+			d.validate(additional, object[name], path, problems)
+		case bool:
+			if !additional {
+				*problems = append(*problems, fmt.Errorf("%s: unexpected property", path))
+			}
+		}
+	}
+}
+
+// resolve looks up a local component schema reference
+func (d *Document) resolve(ref string) (*Schema, error) {
+	name, ok := strings.CutPrefix(ref, "#/components/schemas/")
+	if !ok {
+		return nil, fmt.Errorf("unsupported reference %q", ref)
+	}
+	schema, ok := d.Components.Schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown schema %q", name)
+	}
+	return schema, nil
+}
+
+// jsonType names the JSON type of a decoded value for error messages
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,88 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDocument() *Document {
+	g := newSchemaGenerator()
+	return &Document{
+		Paths: map[string]*PathItem{
+			"/things/{id}": {Get: &Operation{
+				Responses: map[string]*Response{
+					"200": jsonResponse("ok", g.ref(sample{})),
+					"204": {Description: "no body"},
+				},
+			}},
+		},
+		Components: Components{Schemas: g.schemas},
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	const valid = `{
+		"id": 1, "title": "t", "ratio": 0.5, "enabled": true,
+		"created_at": "2025-01-02T03:04:05Z", "tags": null,
+		"child": {"name": "c"}, "children": [{"name": "d"}],
+		"counts": {"a": 1}, "extra": [1, "two"], "Untagged": "", "nested": {"Value": "v"}
+	}`
+
+	tests := []struct {
+		name     string
+		path     string
+		status   int
+		body     string
+		contains []string
+	}{
+		{name: "valid", path: "/things/{id}", status: http.StatusOK, body: valid},
+		{
+			name:   "type mismatches",
+			path:   "/things/{id}",
+			status: http.StatusOK,
+			body: `{
+				"id": 1.5, "title": 2, "ratio": "x", "enabled": "yes",
+				"created_at": "yesterday", "tags": [1], "child": null, "children": [{}],
+				"counts": {"a": "one"}, "extra": null, "Untagged": "", "nested": {"Value": "v"}
+			}`,
+			contains: []string{
+				"$.id: expected integer, got 1.5",
+				"$.title: expected string, got number",
+				"$.ratio: expected number, got string",
+				"$.enabled: expected boolean, got string",
+				`$.created_at: expected date-time, got "yesterday"`,
+				"$.tags[0]: expected string, got number",
+				"$.child: null is not allowed, expected object",
+				`$.children[0]: missing required property "name"`,
+				"$.counts.a: expected integer, got string",
+			},
+		},
+		{
+			name:     "missing and unexpected properties",
+			path:     "/things/{id}",
+			status:   http.StatusOK,
+			body:     `{"id": 1, "surprise": true}`,
+			contains: []string{`$: missing required property "title"`, "$.surprise: unexpected property"},
+		},
+		{name: "undocumented path", path: "/other", status: http.StatusOK, body: `{}`, contains: []string{"GET /other is not documented"}},
+		{name: "undocumented status", path: "/things/{id}", status: http.StatusTeapot, body: `{}`, contains: []string{"does not document status 418"}},
+		{name: "no body documented", path: "/things/{id}", status: http.StatusNoContent, body: `{}`, contains: []string{"has no JSON body"}},
+		{name: "invalid JSON", path: "/things/{id}", status: http.StatusOK, body: `{`, contains: []string{"not valid JSON"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testDocument().ValidateResponse(http.MethodGet, tt.path, tt.status, []byte(tt.body))
+
+			if len(tt.contains) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.contains {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// readContentFile reads a file from the content directory using the same
+// relative layout as the GitHub content repository (e.g. "projects/projects.json")
+func readContentFile(ctx context.Context, dir, relativePath string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(relativePath)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", relativePath, err)
+	}
+	return content, nil
+}
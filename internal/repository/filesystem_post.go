@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FileSystemPostRepository implements PostRepository by reading posts/*.md
+// from a local content directory
+type FileSystemPostRepository struct {
+	dir string
+}
+
+// NewFileSystemPostRepository creates a new filesystem-based post repository
+func NewFileSystemPostRepository(dir string) *FileSystemPostRepository {
+	return &FileSystemPostRepository{
+		dir: dir,
+	}
+}
+
+// GetAllPosts reads all published posts from the content directory, newest first
+func (r *FileSystemPostRepository) GetAllPosts(ctx context.Context) (_ []*models.Post, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemPostRepository.GetAllPosts")
+	defer func() { tracing.End(span, err) }()
+
+	posts, err := r.readPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return publishedPosts(posts), nil
+}
+
+// GetPostBySlug returns a published post by its slug
+func (r *FileSystemPostRepository) GetPostBySlug(ctx context.Context, slug string) (_ *models.Post, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemPostRepository.GetPostBySlug", attribute.String("post.slug", slug))
+	defer func() { tracing.End(span, err) }()
+
+	posts, err := r.readPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findPostBySlug(posts, slug)
+}
+
+// readPosts parses every Markdown file in the posts directory
+func (r *FileSystemPostRepository) readPosts(ctx context.Context) ([]*models.Post, error) {
+	entries, err := os.ReadDir(filepath.Join(r.dir, postsDirectory))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*models.Post{}, nil
+		}
+		return nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+
+	var posts []*models.Post
+	for _, entry := range entries {
+		if entry.IsDir() || !isPostFile(entry.Name()) {
+			continue
+		}
+
+		relativePath := postsDirectory + "/" + entry.Name()
+		content, err := readContentFile(ctx, r.dir, relativePath)
+		if err != nil {
+			log.Error().Err(err).Str("path", relativePath).Msg("Failed to read post")
+			continue
+		}
+
+		post, err := parsePost(entry.Name(), string(content))
+		if err != nil {
+			log.Error().Err(err).Str("path", relativePath).Msg("Failed to parse post")
+			continue
+		}
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+)
+
+// FileSystemProfileRepository implements ProfileRepository by reading
+// profile/profile.json from a local content directory
+type FileSystemProfileRepository struct {
+	dir string
+}
+
+// NewFileSystemProfileRepository creates a new filesystem-based profile repository
+func NewFileSystemProfileRepository(dir string) *FileSystemProfileRepository {
+	return &FileSystemProfileRepository{
+		dir: dir,
+	}
+}
+
+// GetProfile returns the site profile
+func (r *FileSystemProfileRepository) GetProfile(ctx context.Context) (_ *models.Profile, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemProfileRepository.GetProfile")
+	defer func() { tracing.End(span, err) }()
+
+	content, err := readContentFile(ctx, r.dir, profilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseProfile(content)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FileSystemProjectRepository implements ProjectRepository by reading
+// projects/projects.json from a local content directory
+type FileSystemProjectRepository struct {
+	dir      string
+	techRepo TechnologyRepository
+}
+
+// NewFileSystemProjectRepository creates a new filesystem-based project repository
+func NewFileSystemProjectRepository(dir string, techRepo TechnologyRepository) *FileSystemProjectRepository {
+	return &FileSystemProjectRepository{
+		dir:      dir,
+		techRepo: techRepo,
+	}
+}
+
+// GetAllProjects reads all projects from the content directory
+func (r *FileSystemProjectRepository) GetAllProjects(ctx context.Context) (_ []*models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemProjectRepository.GetAllProjects")
+	defer func() { tracing.End(span, err) }()
+
+	content, err := readContentFile(ctx, r.dir, projectsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	projectsResponse, err := parseProjectsData(content)
+	if err != nil {
+		return nil, err
+	}
+
+	technologies := projectTechnologies(ctx, r.techRepo)
+
+	projects := make([]*models.Project, 0, len(projectsResponse.Projects))
+	for _, data := range projectsResponse.Projects {
+		project, err := newProject(data, technologies)
+		if err != nil {
+			log.Error().Err(err).Int("projectID", data.ID).Msg("Failed to convert project data")
+			continue
+		}
+		projects = append(projects, project)
+	}
+
+	log.Debug().Int("count", len(projects)).Str("dir", r.dir).Msg("Loaded projects from filesystem")
+
+	return projects, nil
+}
+
+// GetProjectBySlug returns the project with the given slug from the content directory
+func (r *FileSystemProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (_ *models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemProjectRepository.GetProjectBySlug", attribute.String("project.slug", slug))
+	defer func() { tracing.End(span, err) }()
+
+	projects, err := r.GetAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findProjectBySlug(projects, slug)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+)
+
+// FileSystemSkillRepository implements SkillRepository by reading
+// skills/skills.json from a local content directory
+type FileSystemSkillRepository struct {
+	dir string
+}
+
+// NewFileSystemSkillRepository creates a new filesystem-based skill repository
+func NewFileSystemSkillRepository(dir string) *FileSystemSkillRepository {
+	return &FileSystemSkillRepository{
+		dir: dir,
+	}
+}
+
+// GetSkillCategories returns all skill categories
+func (r *FileSystemSkillRepository) GetSkillCategories(ctx context.Context) (_ []models.SkillCategory, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemSkillRepository.GetSkillCategories")
+	defer func() { tracing.End(span, err) }()
+
+	content, err := readContentFile(ctx, r.dir, "skills/skills.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var skillsResponse models.SkillsResponse
+	if err := json.Unmarshal(content, &skillsResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse skills.json: %w", err)
+	}
+
+	return skillsResponse.SkillCategories, nil
+}
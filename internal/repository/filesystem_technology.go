@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FileSystemTechnologyRepository implements TechnologyRepository by reading
+// technologies/technologies.json from a local content directory.
+// The file is re-read on every call so local edits show up without a restart.
+type FileSystemTechnologyRepository struct {
+	dir string
+}
+
+// NewFileSystemTechnologyRepository creates a new filesystem-based technology repository
+func NewFileSystemTechnologyRepository(dir string) *FileSystemTechnologyRepository {
+	return &FileSystemTechnologyRepository{
+		dir: dir,
+	}
+}
+
+// GetTechnology returns a technology by name
+func (r *FileSystemTechnologyRepository) GetTechnology(ctx context.Context, name string) (_ *models.Technology, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemTechnologyRepository.GetTechnology", attribute.String("technology.name", name))
+	defer func() { tracing.End(span, err) }()
+
+	technologies, err := r.loadTechnologies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tech, exists := technologies[name]
+	if !exists {
+		return nil, fmt.Errorf("technology '%s' not found", name)
+	}
+	return &tech, nil
+}
+
+// GetTechnologies returns multiple technologies by names
+func (r *FileSystemTechnologyRepository) GetTechnologies(ctx context.Context, names []string) []models.Technology {
+	ctx, span := tracing.Start(ctx, "FileSystemTechnologyRepository.GetTechnologies", attribute.StringSlice("technology.names", names))
+	defer span.End()
+
+	technologies, err := r.loadTechnologies(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load technologies")
+		return []models.Technology{}
+	}
+
+	techs := make([]models.Technology, 0, len(names))
+	for _, name := range names {
+		if tech, exists := technologies[name]; exists {
+			techs = append(techs, tech)
+		}
+	}
+	return techs
+}
+
+// GetAllTechnologies returns all available technologies
+func (r *FileSystemTechnologyRepository) GetAllTechnologies(ctx context.Context) (_ map[string]models.Technology, err error) {
+	ctx, span := tracing.Start(ctx, "FileSystemTechnologyRepository.GetAllTechnologies")
+	defer func() { tracing.End(span, err) }()
+
+	return r.loadTechnologies(ctx)
+}
+
+// loadTechnologies reads and parses technologies.json from the content directory
+func (r *FileSystemTechnologyRepository) loadTechnologies(ctx context.Context) (map[string]models.Technology, error) {
+	content, err := readContentFile(ctx, r.dir, "technologies/technologies.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var techResponse models.TechnologiesResponse
+	if err := json.Unmarshal(content, &techResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse technologies.json: %w", err)
+	}
+
+	if techResponse.Technologies == nil {
+		return make(map[string]models.Technology), nil
+	}
+	return techResponse.Technologies, nil
+}
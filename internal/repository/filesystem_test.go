@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeContentFile(t *testing.T, dir, relativePath, content string) {
+	t.Helper()
+	fullPath := filepath.Join(dir, filepath.FromSlash(relativePath))
+	require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0o755))
+	require.NoError(t, os.WriteFile(fullPath, []byte(content), 0o644))
+}
+
+func newContentDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	writeContentFile(t, dir, "technologies/technologies.json", `{
+		"technologies": {
+			"Go": {"name": "Go", "icon": "go.svg"},
+			"Redis": {"name": "Redis", "icon": "redis.svg"}
+		}
+	}`)
+	writeContentFile(t, dir, "skills/skills.json", `{
+		"skill_categories": [
+			{"category": "Backend", "skills": [{"name": "Go", "icon": "go.svg"}]}
+		]
+	}`)
+	writeContentFile(t, dir, "projects/projects.json", `{
+		"projects": [
+			{"id": 1, "title": "Cache", "technologies": ["Go", "Redis", "Unknown"], "featured": true}
+		]
+	}`)
+	writeContentFile(t, dir, "profile/profile.json", `{
+		"name": "Jane Doe",
+		"headline": "Software Engineer",
+		"about": ["First paragraph.", "Second paragraph."],
+		"social": {"github": "https://github.com/janedoe"}
+	}`)
+	writeContentFile(t, dir, "posts/hello.md", "---\ntitle: Hello\ndate: 2024-01-02\n---\nBody")
+	writeContentFile(t, dir, "posts/draft.md", "---\ntitle: Draft\ndraft: true\n---\nBody")
+	writeContentFile(t, dir, "posts/notes.txt", "not a post")
+	return dir
+}
+
+func TestFileSystemRepositories(t *testing.T) {
+	dir := newContentDir(t)
+	techRepo := NewFileSystemTechnologyRepository(dir)
+
+	t.Run("reads technologies", func(t *testing.T) {
+		all, err := techRepo.GetAllTechnologies(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, all, 2)
+
+		tech, err := techRepo.GetTechnology(context.Background(), "Go")
+		assert.NoError(t, err)
+		assert.Equal(t, &models.Technology{Name: "Go", Icon: "go.svg"}, tech)
+
+		_, err = techRepo.GetTechnology(context.Background(), "Python")
+		assert.Error(t, err)
+	})
+
+	t.Run("reads skills", func(t *testing.T) {
+		categories, err := NewFileSystemSkillRepository(dir).GetSkillCategories(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, categories, 1)
+		assert.Equal(t, "Backend", categories[0].Category)
+	})
+
+	t.Run("reads projects and resolves technologies", func(t *testing.T) {
+		projects, err := NewFileSystemProjectRepository(dir, techRepo).GetAllProjects(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, projects, 1)
+		assert.Equal(t, "Cache", projects[0].Title)
+		assert.Len(t, projects[0].Technologies, 2)
+	})
+
+	t.Run("finds projects by slug", func(t *testing.T) {
+		projectRepo := NewFileSystemProjectRepository(dir, techRepo)
+
+		project, err := projectRepo.GetProjectBySlug(context.Background(), "cache")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, project.ID)
+
+		_, err = projectRepo.GetProjectBySlug(context.Background(), "missing")
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+	})
+
+	t.Run("reads published posts", func(t *testing.T) {
+		postRepo := NewFileSystemPostRepository(dir)
+
+		posts, err := postRepo.GetAllPosts(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, posts, 1)
+		assert.Equal(t, "hello", posts[0].Slug)
+
+		_, err = postRepo.GetPostBySlug(context.Background(), "draft")
+		assert.ErrorIs(t, err, ErrPostNotFound)
+	})
+
+	t.Run("reads profile", func(t *testing.T) {
+		profile, err := NewFileSystemProfileRepository(dir).GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane Doe", profile.Name)
+		assert.Equal(t, []string{"First paragraph.", "Second paragraph."}, profile.About)
+		assert.Equal(t, "https://github.com/janedoe", profile.Social.GitHub)
+	})
+}
+
+func TestFileSystemRepositories_MissingContent(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := NewFileSystemProjectRepository(dir, NewFileSystemTechnologyRepository(dir)).GetAllProjects(context.Background())
+	assert.Error(t, err)
+
+	_, err = NewFileSystemSkillRepository(dir).GetSkillCategories(context.Background())
+	assert.Error(t, err)
+
+	assert.Empty(t, NewFileSystemTechnologyRepository(dir).GetTechnologies(context.Background(), []string{"Go"}))
+
+	posts, err := NewFileSystemPostRepository(dir).GetAllPosts(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, posts)
+
+	_, err = NewFileSystemProfileRepository(dir).GetProfile(context.Background())
+	assert.Error(t, err)
+}
+
+func TestDefaultProfile(t *testing.T) {
+	profile := DefaultProfile()
+
+	assert.Equal(t, "Benjamin Idewor", profile.Name)
+	assert.NotEmpty(t, profile.Headline)
+	assert.NotEmpty(t, profile.About)
+	assert.NotEmpty(t, profile.Email)
+	assert.NotEmpty(t, profile.Social.LinkedIn)
+	assert.NotEmpty(t, profile.CoreTechnologies)
+	assert.NotEmpty(t, profile.Contact.Heading)
+
+	profile.Name = "Changed"
+	assert.Equal(t, "Benjamin Idewor", DefaultProfile().Name, "callers get a copy")
+
+	memoryProfile, err := NewInMemoryProfileRepository().GetProfile(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultProfile(), memoryProfile)
+}
+
+func TestParseProfile(t *testing.T) {
+	profile, err := parseProfile([]byte(`{"name": "Jane Doe", "headline": "Engineer"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Engineer", profile.Headline)
+
+	_, err = parseProfile([]byte(`{"headline": "Engineer"}`))
+	assert.ErrorContains(t, err, "missing a name")
+
+	_, err = parseProfile([]byte(`{`))
+	assert.Error(t, err)
+}
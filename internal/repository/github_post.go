@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/benidevo/website/internal/client"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GitHubPostRepository implements PostRepository using GitHub API
+type GitHubPostRepository struct {
+	githubClient *client.GitHubClient
+	snapshot     *snapshotStore[[]*models.Post] // Every post from the last successful load, drafts included
+	readyTimeout time.Duration                  // How long reads wait for the initial load
+}
+
+// NewGitHubPostRepository creates a new GitHub-based post repository
+func NewGitHubPostRepository(githubClient *client.GitHubClient) *GitHubPostRepository {
+	repo := &GitHubPostRepository{
+		githubClient: githubClient,
+		snapshot:     newSnapshotStore[[]*models.Post]("posts"),
+		readyTimeout: defaultReadyTimeout,
+	}
+
+	go repo.InitializeAsync(context.Background())
+
+	return repo
+}
+
+// GetAllPosts returns all published posts, newest first, waiting briefly for the initial load
+func (r *GitHubPostRepository) GetAllPosts(ctx context.Context) (_ []*models.Post, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubPostRepository.GetAllPosts")
+	defer func() { tracing.End(span, err) }()
+
+	snapshot, err := r.snapshot.Wait(ctx, r.readyTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("posts unavailable: %w", err)
+	}
+	return publishedPosts(*snapshot), nil
+}
+
+// GetPostBySlug returns a published post by its slug
+func (r *GitHubPostRepository) GetPostBySlug(ctx context.Context, slug string) (_ *models.Post, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubPostRepository.GetPostBySlug", attribute.String("post.slug", slug))
+	defer func() { tracing.End(span, err) }()
+
+	snapshot, err := r.snapshot.Wait(ctx, r.readyTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("posts unavailable: %w", err)
+	}
+	return findPostBySlug(*snapshot, slug)
+}
+
+// InitializeAsync loads posts in the background, retrying until the first load succeeds or ctx is done
+func (r *GitHubPostRepository) InitializeAsync(ctx context.Context) {
+	loadWithRetry(ctx, r.loadPosts, func(err error, retryIn time.Duration) {
+		log.Error().Err(err).Dur("retry_in", retryIn).Msg("Failed to load posts asynchronously")
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	log.Info().Msg("Posts loaded asynchronously")
+}
+
+// Ready returns a channel that is closed once posts have been loaded
+func (r *GitHubPostRepository) Ready() <-chan struct{} {
+	return r.snapshot.Ready()
+}
+
+// LoadedAt returns when posts were last loaded successfully
+func (r *GitHubPostRepository) LoadedAt() time.Time {
+	return r.snapshot.LoadedAt()
+}
+
+// loadPosts lists posts/*.md on GitHub, parses each file and swaps in a new snapshot.
+// A content repository without a posts directory has no posts. Files that fail to
+// parse are skipped, but a failed fetch fails the load so the previous snapshot is kept.
+func (r *GitHubPostRepository) loadPosts(ctx context.Context) error {
+	names, err := r.githubClient.ListDirectory(ctx, postsDirectory)
+	if errors.Is(err, client.ErrNotFound) {
+		log.Debug().Msg("No posts directory in the content repository")
+		r.snapshot.Store(&[]*models.Post{})
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list posts: %w", err)
+	}
+
+	posts := make([]*models.Post, 0, len(names))
+	for _, name := range names {
+		if !isPostFile(name) {
+			continue
+		}
+
+		filePath := path.Join(postsDirectory, name)
+		content, err := r.githubClient.FetchFileContent(ctx, filePath)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", filePath, err)
+		}
+
+		post, err := parsePost(name, content)
+		if err != nil {
+			log.Error().Err(err).Str("path", filePath).Msg("Failed to parse post")
+			continue
+		}
+		posts = append(posts, post)
+	}
+
+	r.snapshot.Store(&posts)
+	return nil
+}
+
+// RefreshPosts reloads posts from GitHub. The previous snapshot keeps being served if the reload fails.
+func (r *GitHubPostRepository) RefreshPosts(ctx context.Context) error {
+	return r.loadPosts(ctx)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/benidevo/website/internal/client"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
+	"github.com/rs/zerolog/log"
+)
+
+// GitHubProfileRepository implements ProfileRepository using GitHub API
+type GitHubProfileRepository struct {
+	githubClient *client.GitHubClient
+	snapshot     *snapshotStore[models.Profile] // Profile from the last successful load
+}
+
+// NewGitHubProfileRepository creates a new GitHub-based profile repository
+func NewGitHubProfileRepository(githubClient *client.GitHubClient) *GitHubProfileRepository {
+	repo := &GitHubProfileRepository{
+		githubClient: githubClient,
+		snapshot:     newSnapshotStore[models.Profile]("profile"),
+	}
+
+	go repo.InitializeAsync(context.Background())
+
+	return repo
+}
+
+// GetProfile returns the profile from the last successful load. It does not wait for
+// the initial load, since callers fall back to the default profile, and never calls
+// GitHub itself, so page views cannot spend the rate limit on a missing profile.json.
+func (r *GitHubProfileRepository) GetProfile(ctx context.Context) (_ *models.Profile, err error) {
+	_, span := tracing.Start(ctx, "GitHubProfileRepository.GetProfile")
+	defer func() { tracing.End(span, err) }()
+
+	snapshot := r.snapshot.Load()
+	if snapshot == nil {
+		return nil, fmt.Errorf("profile unavailable: %w", ErrNotReady)
+	}
+
+	profile := *snapshot
+	return &profile, nil
+}
+
+// InitializeAsync loads the profile in the background, retrying until the first load succeeds or ctx is done
+func (r *GitHubProfileRepository) InitializeAsync(ctx context.Context) {
+	loadWithRetry(ctx, r.loadProfile, func(err error, retryIn time.Duration) {
+		log.Warn().Err(err).Dur("retry_in", retryIn).Msg("Failed to load profile asynchronously, serving the default profile")
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	log.Info().Msg("Profile loaded asynchronously")
+}
+
+// loadProfile fetches profile/profile.json from GitHub and swaps in a new snapshot
+func (r *GitHubProfileRepository) loadProfile(ctx context.Context) error {
+	content, err := r.githubClient.FetchFileContent(ctx, profilePath)
+	if err != nil {
+		return fmt.Errorf("failed to fetch profile.json: %w", err)
+	}
+
+	profile, err := parseProfile([]byte(content))
+	if err != nil {
+		return err
+	}
+
+	r.snapshot.Store(profile)
+	return nil
+}
+
+// RefreshProfile reloads the profile from GitHub. The previous snapshot keeps being served if the reload fails.
+func (r *GitHubProfileRepository) RefreshProfile(ctx context.Context) error {
+	return r.loadProfile(ctx)
+}
@@ -1,45 +1,53 @@
 package repository
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/benidevo/website/internal/client"
-	"github.com/benidevo/website/internal/config"
 	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // GitHubProjectRepository implements ProjectRepository using GitHub API
 type GitHubProjectRepository struct {
-	cfg          *config.GitHubConfig
 	githubClient *client.GitHubClient
 	techRepo     TechnologyRepository
+	fetched      *snapshotStore[models.ProjectsResponse] // projects.json as GitHub last returned it
 }
 
 // NewGitHubProjectRepository creates a new GitHub-based project repository
-func NewGitHubProjectRepository(cfg *config.GitHubConfig, techRepo TechnologyRepository) *GitHubProjectRepository {
+func NewGitHubProjectRepository(githubClient *client.GitHubClient, techRepo TechnologyRepository) *GitHubProjectRepository {
 	repo := &GitHubProjectRepository{
-		cfg:          cfg,
-		githubClient: client.NewGitHubClient(cfg),
+		githubClient: githubClient,
 		techRepo:     techRepo,
+		fetched:      newSnapshotStore[models.ProjectsResponse]("projects"),
 	}
 
-	go repo.PrewarmCache()
+	githubClient.OnFetch(projectsPath, repo.recordProjectsFetch)
+	go repo.PrewarmCache(context.Background())
 
 	return repo
 }
 
 // GetAllProjects fetches all projects from GitHub repository
-func (r *GitHubProjectRepository) GetAllProjects() ([]*models.Project, error) {
-	projectsData, err := r.fetchProjectsData()
+func (r *GitHubProjectRepository) GetAllProjects(ctx context.Context) (_ []*models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubProjectRepository.GetAllProjects")
+	defer func() { tracing.End(span, err) }()
+
+	projectsData, err := r.fetchProjectsData(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch projects data: %w", err)
 	}
 
+	technologies := projectTechnologies(ctx, r.techRepo)
+
 	var projects []*models.Project
 	for _, projectData := range projectsData.Projects {
-		project, err := r.convertToProject(projectData)
+		project, err := newProject(projectData, technologies)
 		if err != nil {
 			log.Error().Err(err).Int("projectID", projectData.ID).Msg("Failed to convert project data")
 			continue
@@ -50,45 +58,66 @@ func (r *GitHubProjectRepository) GetAllProjects() ([]*models.Project, error) {
 	return projects, nil
 }
 
-// fetchProjectsData fetches and parses projects.json from GitHub
-func (r *GitHubProjectRepository) fetchProjectsData() (*models.ProjectsResponse, error) {
-	content, err := r.githubClient.FetchFileContent("projects/projects.json")
+// GetProjectBySlug returns the project with the given slug from the GitHub repository
+func (r *GitHubProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (_ *models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubProjectRepository.GetProjectBySlug", attribute.String("project.slug", slug))
+	defer func() { tracing.End(span, err) }()
+
+	projects, err := r.GetAllProjects(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return findProjectBySlug(projects, slug)
+}
 
-	var projectsResponse models.ProjectsResponse
-	if err := json.Unmarshal([]byte(content), &projectsResponse); err != nil {
-		return nil, fmt.Errorf("failed to parse projects.json: %w", err)
+// fetchProjectsData fetches and parses projects.json from GitHub
+func (r *GitHubProjectRepository) fetchProjectsData(ctx context.Context) (*models.ProjectsResponse, error) {
+	content, err := r.githubClient.FetchFileContent(ctx, projectsPath)
+	if err != nil {
+		return nil, err
 	}
+	return parseProjectsData([]byte(content))
+}
 
-	return &projectsResponse, nil
+// recordProjectsFetch records a projects load each time GitHub returns a valid projects.json,
+// whether for the prewarm, a TTL refresh or a webhook refresh. Reads served from the client
+// cache are not loads, so they leave the load time and metric alone.
+func (r *GitHubProjectRepository) recordProjectsFetch(content string, _ time.Time) {
+	projectsResponse, err := parseProjectsData([]byte(content))
+	if err != nil {
+		return
+	}
+	r.fetched.Store(projectsResponse)
 }
 
-// PrewarmCache loads project data asynchronously to warm up the cache
-func (r *GitHubProjectRepository) PrewarmCache() {
+// PrewarmCache loads project data asynchronously to warm up the cache, retrying until
+// the first load succeeds or ctx is done
+func (r *GitHubProjectRepository) PrewarmCache(ctx context.Context) {
 	log.Info().Msg("Pre-warming project cache asynchronously")
-	if _, err := r.GetAllProjects(); err != nil {
-		log.Error().Err(err).Msg("Failed to pre-warm project cache")
-	} else {
-		log.Info().Msg("Project cache pre-warmed successfully")
+	loadWithRetry(ctx, func(ctx context.Context) error {
+		_, err := r.GetAllProjects(ctx)
+		return err
+	}, func(err error, retryIn time.Duration) {
+		log.Error().Err(err).Dur("retry_in", retryIn).Msg("Failed to pre-warm project cache")
+	})
+	if ctx.Err() != nil {
+		return
 	}
+	log.Info().Msg("Project cache pre-warmed successfully")
 }
 
-// convertToProject converts ProjectData to models.Project
-func (r *GitHubProjectRepository) convertToProject(data models.ProjectData) (*models.Project, error) {
-	technologies := r.techRepo.GetTechnologies(data.Technologies)
-
-	project := &models.Project{
-		ID:           data.ID,
-		Title:        data.Title,
-		Description:  data.Description,
-		GitHubURL:    data.GitHubURL,
-		LiveURL:      data.LiveURL,
-		Language:     data.Language,
-		Technologies: technologies,
-		Featured:     data.Featured,
-	}
+// RefreshProjects reloads projects.json from GitHub to repopulate the cache after an invalidation
+func (r *GitHubProjectRepository) RefreshProjects(ctx context.Context) error {
+	_, err := r.fetchProjectsData(ctx)
+	return err
+}
+
+// Ready returns a channel that is closed once GitHub has first returned projects.json
+func (r *GitHubProjectRepository) Ready() <-chan struct{} {
+	return r.fetched.Ready()
+}
 
-	return project, nil
+// LoadedAt returns when GitHub last returned projects.json, or the zero time before it has
+func (r *GitHubProjectRepository) LoadedAt() time.Time {
+	return r.fetched.LoadedAt()
 }
@@ -1,76 +1,78 @@
 package repository
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/benidevo/website/internal/client"
-	"github.com/benidevo/website/internal/config"
 	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
 	"github.com/rs/zerolog/log"
 )
 
 // GitHubSkillRepository implements SkillRepository using GitHub API
 type GitHubSkillRepository struct {
-	cfg             *config.GitHubConfig
-	githubClient    *client.GitHubClient
-	skillCategories []models.SkillCategory // Cache for skill categories
-	initialized     bool                   // Track initialization status
+	githubClient *client.GitHubClient
+	snapshot     *snapshotStore[[]models.SkillCategory] // Skill categories from the last successful load
+	readyTimeout time.Duration                          // How long reads wait for the initial load
 }
 
 // NewGitHubSkillRepository creates a new GitHub-based skill repository
-func NewGitHubSkillRepository(cfg *config.GitHubConfig) *GitHubSkillRepository {
+func NewGitHubSkillRepository(githubClient *client.GitHubClient) *GitHubSkillRepository {
 	repo := &GitHubSkillRepository{
-		cfg:          cfg,
-		githubClient: client.NewGitHubClient(cfg),
-		initialized:  false,
+		githubClient: githubClient,
+		snapshot:     newSnapshotStore[[]models.SkillCategory]("skills"),
+		readyTimeout: defaultReadyTimeout,
 	}
 
-	go repo.InitializeAsync()
+	go repo.InitializeAsync(context.Background())
 
 	return repo
 }
 
-// GetSkillCategories returns all skill categories
-func (r *GitHubSkillRepository) GetSkillCategories() ([]models.SkillCategory, error) {
-	if err := r.ensureSkillsLoaded(); err != nil {
-		return nil, err
+// GetSkillCategories returns all skill categories, waiting briefly for the initial load
+func (r *GitHubSkillRepository) GetSkillCategories(ctx context.Context) (_ []models.SkillCategory, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubSkillRepository.GetSkillCategories")
+	defer func() { tracing.End(span, err) }()
+
+	snapshot, err := r.snapshot.Wait(ctx, r.readyTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("skills unavailable: %w", err)
 	}
 
-	categories := make([]models.SkillCategory, len(r.skillCategories))
-	copy(categories, r.skillCategories)
+	categories := make([]models.SkillCategory, len(*snapshot))
+	copy(categories, *snapshot)
 	return categories, nil
 }
 
-// InitializeAsync loads skills asynchronously in the background
-func (r *GitHubSkillRepository) InitializeAsync() {
-	if err := r.loadSkills(); err != nil {
-		log.Error().Err(err).Msg("Failed to load skills asynchronously")
-	} else {
-		r.initialized = true
-		log.Info().Msg("Skills loaded asynchronously")
+// InitializeAsync loads skills in the background, retrying until the first load succeeds or ctx is done
+func (r *GitHubSkillRepository) InitializeAsync(ctx context.Context) {
+	loadWithRetry(ctx, r.loadSkills, func(err error, retryIn time.Duration) {
+		log.Error().Err(err).Dur("retry_in", retryIn).Msg("Failed to load skills asynchronously")
+	})
+	if ctx.Err() != nil {
+		return
 	}
+	log.Info().Msg("Skills loaded asynchronously")
 }
 
-// ensureSkillsLoaded loads skills from GitHub if not already loaded
-func (r *GitHubSkillRepository) ensureSkillsLoaded() error {
-	if len(r.skillCategories) > 0 {
-		return nil
-	}
-
-	if !r.initialized {
-		log.Warn().Msg("Skills not loaded asynchronously, loading synchronously")
-		return r.loadSkills()
-	}
+// Ready returns a channel that is closed once skills have been loaded
+func (r *GitHubSkillRepository) Ready() <-chan struct{} {
+	return r.snapshot.Ready()
+}
 
-	return nil
+// LoadedAt returns when skills were last loaded successfully
+func (r *GitHubSkillRepository) LoadedAt() time.Time {
+	return r.snapshot.LoadedAt()
 }
 
-// loadSkills fetches and loads skills from GitHub
-func (r *GitHubSkillRepository) loadSkills() error {
+// loadSkills fetches skills from GitHub and swaps in a new snapshot
+func (r *GitHubSkillRepository) loadSkills(ctx context.Context) error {
 	url := "skills/skills.json"
 
-	content, err := r.githubClient.FetchFileContent(url)
+	content, err := r.githubClient.FetchFileContent(ctx, url)
 	if err != nil {
 		return fmt.Errorf("failed to fetch skills.json: %w", err)
 	}
@@ -80,14 +82,14 @@ func (r *GitHubSkillRepository) loadSkills() error {
 		return fmt.Errorf("failed to parse skills.json: %w", err)
 	}
 
-	r.skillCategories = skillsResponse.SkillCategories
-	log.Info().Int("categories", len(r.skillCategories)).Msg("Loaded skill categories from GitHub")
+	categories := skillsResponse.SkillCategories
+	r.snapshot.Store(&categories)
+	log.Info().Int("categories", len(categories)).Msg("Loaded skill categories from GitHub")
 
 	return nil
 }
 
-// RefreshSkills forces a reload of skills from GitHub
-func (r *GitHubSkillRepository) RefreshSkills() error {
-	r.skillCategories = nil
-	return r.loadSkills()
+// RefreshSkills reloads skills from GitHub. The previous snapshot keeps being served if the reload fails.
+func (r *GitHubSkillRepository) RefreshSkills(ctx context.Context) error {
+	return r.loadSkills(ctx)
 }
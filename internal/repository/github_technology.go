@@ -1,44 +1,49 @@
 package repository
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/benidevo/website/internal/client"
-	"github.com/benidevo/website/internal/config"
 	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/tracing"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // GitHubTechnologyRepository implements TechnologyRepository using GitHub API
 type GitHubTechnologyRepository struct {
-	cfg          *config.GitHubConfig
 	githubClient *client.GitHubClient
-	technologies map[string]models.Technology // Cache for technologies
-	initialized  bool                         // Track initialization status
+	snapshot     *snapshotStore[map[string]models.Technology] // Technologies from the last successful load
+	readyTimeout time.Duration                                // How long reads wait for the initial load
 }
 
 // NewGitHubTechnologyRepository creates a new GitHub-based technology repository
-func NewGitHubTechnologyRepository(cfg *config.GitHubConfig) *GitHubTechnologyRepository {
+func NewGitHubTechnologyRepository(githubClient *client.GitHubClient) *GitHubTechnologyRepository {
 	repo := &GitHubTechnologyRepository{
-		cfg:          cfg,
-		githubClient: client.NewGitHubClient(cfg),
-		technologies: make(map[string]models.Technology),
-		initialized:  false,
+		githubClient: githubClient,
+		snapshot:     newSnapshotStore[map[string]models.Technology]("technologies"),
+		readyTimeout: defaultReadyTimeout,
 	}
 
-	go repo.InitializeAsync()
+	go repo.InitializeAsync(context.Background())
 
 	return repo
 }
 
 // GetTechnology returns a technology by name
-func (r *GitHubTechnologyRepository) GetTechnology(name string) (*models.Technology, error) {
-	if err := r.ensureTechnologiesLoaded(); err != nil {
+func (r *GitHubTechnologyRepository) GetTechnology(ctx context.Context, name string) (_ *models.Technology, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubTechnologyRepository.GetTechnology", attribute.String("technology.name", name))
+	defer func() { tracing.End(span, err) }()
+
+	technologies, err := r.currentTechnologies(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	tech, exists := r.technologies[name]
+	tech, exists := technologies[name]
 	if !exists {
 		return nil, fmt.Errorf("technology '%s' not found", name)
 	}
@@ -46,15 +51,19 @@ func (r *GitHubTechnologyRepository) GetTechnology(name string) (*models.Technol
 }
 
 // GetTechnologies returns multiple technologies by names
-func (r *GitHubTechnologyRepository) GetTechnologies(names []string) []models.Technology {
-	if err := r.ensureTechnologiesLoaded(); err != nil {
+func (r *GitHubTechnologyRepository) GetTechnologies(ctx context.Context, names []string) []models.Technology {
+	ctx, span := tracing.Start(ctx, "GitHubTechnologyRepository.GetTechnologies", attribute.StringSlice("technology.names", names))
+	defer span.End()
+
+	technologies, err := r.currentTechnologies(ctx)
+	if err != nil {
 		log.Error().Err(err).Msg("Failed to load technologies")
 		return []models.Technology{}
 	}
 
 	techs := make([]models.Technology, 0, len(names))
 	for _, name := range names {
-		if tech, exists := r.technologies[name]; exists {
+		if tech, exists := technologies[name]; exists {
 			techs = append(techs, tech)
 		}
 	}
@@ -62,47 +71,58 @@ func (r *GitHubTechnologyRepository) GetTechnologies(names []string) []models.Te
 }
 
 // GetAllTechnologies returns all available technologies
-func (r *GitHubTechnologyRepository) GetAllTechnologies() (map[string]models.Technology, error) {
-	if err := r.ensureTechnologiesLoaded(); err != nil {
+func (r *GitHubTechnologyRepository) GetAllTechnologies(ctx context.Context) (_ map[string]models.Technology, err error) {
+	ctx, span := tracing.Start(ctx, "GitHubTechnologyRepository.GetAllTechnologies")
+	defer func() { tracing.End(span, err) }()
+
+	technologies, err := r.currentTechnologies(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	result := make(map[string]models.Technology)
-	for k, v := range r.technologies {
+	result := make(map[string]models.Technology, len(technologies))
+	for k, v := range technologies {
 		result[k] = v
 	}
 	return result, nil
 }
 
-// InitializeAsync loads technologies asynchronously in the background
-func (r *GitHubTechnologyRepository) InitializeAsync() {
-	if err := r.loadTechnologies(); err != nil {
-		log.Error().Err(err).Msg("Failed to load technologies asynchronously")
-	} else {
-		r.initialized = true
-		log.Info().Msg("Technologies loaded asynchronously")
+// InitializeAsync loads technologies in the background, retrying until the first load succeeds or ctx is done
+func (r *GitHubTechnologyRepository) InitializeAsync(ctx context.Context) {
+	loadWithRetry(ctx, r.loadTechnologies, func(err error, retryIn time.Duration) {
+		log.Error().Err(err).Dur("retry_in", retryIn).Msg("Failed to load technologies asynchronously")
+	})
+	if ctx.Err() != nil {
+		return
 	}
+	log.Info().Msg("Technologies loaded asynchronously")
 }
 
-// ensureTechnologiesLoaded loads technologies from GitHub if not already loaded
-func (r *GitHubTechnologyRepository) ensureTechnologiesLoaded() error {
-	if len(r.technologies) > 0 {
-		return nil
-	}
+// Ready returns a channel that is closed once technologies have been loaded
+func (r *GitHubTechnologyRepository) Ready() <-chan struct{} {
+	return r.snapshot.Ready()
+}
 
-	if !r.initialized {
-		log.Warn().Msg("Technologies not loaded asynchronously, loading synchronously")
-		return r.loadTechnologies()
-	}
+// LoadedAt returns when technologies were last loaded successfully
+func (r *GitHubTechnologyRepository) LoadedAt() time.Time {
+	return r.snapshot.LoadedAt()
+}
 
-	return nil
+// currentTechnologies returns the current snapshot, waiting briefly for the initial load.
+// The returned map is shared and must not be modified.
+func (r *GitHubTechnologyRepository) currentTechnologies(ctx context.Context) (map[string]models.Technology, error) {
+	snapshot, err := r.snapshot.Wait(ctx, r.readyTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("technologies unavailable: %w", err)
+	}
+	return *snapshot, nil
 }
 
-// loadTechnologies fetches and loads technologies from GitHub
-func (r *GitHubTechnologyRepository) loadTechnologies() error {
+// loadTechnologies fetches technologies from GitHub and swaps in a new snapshot
+func (r *GitHubTechnologyRepository) loadTechnologies(ctx context.Context) error {
 	url := "technologies/technologies.json"
 
-	content, err := r.githubClient.FetchFileContent(url)
+	content, err := r.githubClient.FetchFileContent(ctx, url)
 	if err != nil {
 		return fmt.Errorf("failed to fetch technologies.json: %w", err)
 	}
@@ -112,14 +132,17 @@ func (r *GitHubTechnologyRepository) loadTechnologies() error {
 		return fmt.Errorf("failed to parse technologies.json: %w", err)
 	}
 
-	r.technologies = techResponse.Technologies
-	log.Info().Int("count", len(r.technologies)).Msg("Loaded technologies from GitHub")
+	technologies := techResponse.Technologies
+	if technologies == nil {
+		technologies = make(map[string]models.Technology)
+	}
+	r.snapshot.Store(&technologies)
+	log.Info().Int("count", len(technologies)).Msg("Loaded technologies from GitHub")
 
 	return nil
 }
 
-// RefreshTechnologies forces a reload of technologies from GitHub
-func (r *GitHubTechnologyRepository) RefreshTechnologies() error {
-	r.technologies = make(map[string]models.Technology) // Clear cache
-	return r.loadTechnologies()
+// RefreshTechnologies reloads technologies from GitHub. The previous snapshot keeps being served if the reload fails.
+func (r *GitHubTechnologyRepository) RefreshTechnologies(ctx context.Context) error {
+	return r.loadTechnologies(ctx)
 }
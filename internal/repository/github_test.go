@@ -1,10 +1,23 @@
 package repository
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/benidevo/website/internal/client"
 	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/metrics"
+	"github.com/benidevo/website/internal/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewGitHubRepositories(t *testing.T) {
@@ -14,31 +27,395 @@ func TestNewGitHubRepositories(t *testing.T) {
 		Token:      "test-token",
 		BaseURL:    "https://api.github.com",
 	}
+	githubClient := client.NewGitHubClient(cfg)
 
 	t.Run("creates project repository", func(t *testing.T) {
 		techRepo := NewInMemoryTechnologyRepository()
-		repo := NewGitHubProjectRepository(cfg, techRepo)
+		repo := NewGitHubProjectRepository(githubClient, techRepo)
 
 		assert.NotNil(t, repo)
-		assert.Equal(t, cfg, repo.cfg)
-		assert.NotNil(t, repo.githubClient)
+		assert.Same(t, githubClient, repo.githubClient)
 		assert.Equal(t, techRepo, repo.techRepo)
 	})
 
 	t.Run("creates skill repository", func(t *testing.T) {
-		repo := NewGitHubSkillRepository(cfg)
+		repo := NewGitHubSkillRepository(githubClient)
 
 		assert.NotNil(t, repo)
-		assert.Equal(t, cfg, repo.cfg)
-		assert.NotNil(t, repo.githubClient)
-		assert.False(t, repo.initialized)
+		assert.Same(t, githubClient, repo.githubClient)
+		assert.NotNil(t, repo.snapshot)
+	})
+
+	t.Run("creates profile repository", func(t *testing.T) {
+		repo := NewGitHubProfileRepository(githubClient)
+
+		assert.NotNil(t, repo)
+		assert.Same(t, githubClient, repo.githubClient)
 	})
 
 	t.Run("creates technology repository", func(t *testing.T) {
-		repo := NewGitHubTechnologyRepository(cfg)
+		repo := NewGitHubTechnologyRepository(githubClient)
 
 		assert.NotNil(t, repo)
-		assert.Equal(t, cfg, repo.cfg)
-		assert.NotNil(t, repo.githubClient)
+		assert.Same(t, githubClient, repo.githubClient)
 	})
 }
+
+func newContentServer(t *testing.T, files map[string]string, status *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		for path, content := range files {
+			if strings.HasSuffix(r.URL.Path, "/contents/"+path) {
+				json.NewEncoder(w).Encode(client.GitHubFile{
+					Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+					Encoding: "base64",
+				})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitHubProfileRepository(t *testing.T) {
+	var requests int32
+	status := int32(http.StatusOK)
+	files := map[string]string{
+		"profile/profile.json": `{"name":"Jane Doe","location":"Lisbon, Portugal"}`,
+	}
+	server := newContentServer(t, files, &status)
+	counted := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(counted.Close)
+
+	githubClient := client.NewGitHubClient(&config.GitHubConfig{BaseURL: counted.URL})
+	repo := NewGitHubProfileRepository(githubClient)
+
+	select {
+	case <-repo.snapshot.Ready():
+	case <-time.After(time.Second):
+		t.Fatal("profile did not load")
+	}
+
+	profile, err := repo.GetProfile(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", profile.Name)
+	assert.Equal(t, "Lisbon, Portugal", profile.Location)
+
+	// Reads are served from the snapshot, even when GitHub is failing
+	atomic.StoreInt32(&status, http.StatusNotFound)
+	assert.Equal(t, 1, githubClient.InvalidatePaths("profile/profile.json"))
+	before := atomic.LoadInt32(&requests)
+	for i := 0; i < 5; i++ {
+		profile, err = repo.GetProfile(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane Doe", profile.Name)
+	}
+	assert.Equal(t, before, atomic.LoadInt32(&requests), "page views must not call GitHub")
+
+	// A failed refresh keeps the previous profile
+	assert.Error(t, repo.RefreshProfile(context.Background()))
+	profile, err = repo.GetProfile(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", profile.Name)
+}
+
+func TestGitHubProfileRepository_NotLoaded(t *testing.T) {
+	status := int32(http.StatusNotFound)
+	server := newContentServer(t, nil, &status)
+
+	repo := NewGitHubProfileRepository(client.NewGitHubClient(&config.GitHubConfig{BaseURL: server.URL}))
+
+	_, err := repo.GetProfile(context.Background())
+	assert.ErrorIs(t, err, ErrNotReady)
+}
+
+func TestGitHubSnapshotRepositories(t *testing.T) {
+	status := int32(http.StatusOK)
+	server := newContentServer(t, map[string]string{
+		"skills/skills.json":             `{"skill_categories":[{"category":"Backend","skills":[{"name":"Go"}]}]}`,
+		"technologies/technologies.json": `{"technologies":{"Go":{"name":"Go","icon":"go.svg"}}}`,
+	}, &status)
+
+	cfg := &config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	}
+
+	githubClient := client.NewGitHubClient(cfg)
+	skillRepo := NewGitHubSkillRepository(githubClient)
+	techRepo := NewGitHubTechnologyRepository(githubClient)
+
+	select {
+	case <-skillRepo.Ready():
+	case <-time.After(time.Second):
+		t.Fatal("skills did not load")
+	}
+	select {
+	case <-techRepo.Ready():
+	case <-time.After(time.Second):
+		t.Fatal("technologies did not load")
+	}
+
+	categories, err := skillRepo.GetSkillCategories(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+	assert.Len(t, techRepo.GetTechnologies(context.Background(), []string{"Go", "Rust"}), 1)
+
+	// A failed refresh keeps serving the previous snapshot
+	atomic.StoreInt32(&status, http.StatusUnauthorized)
+	assert.Equal(t, 2, githubClient.InvalidatePaths("skills/skills.json", "technologies/technologies.json"))
+
+	assert.Error(t, skillRepo.RefreshSkills(context.Background()))
+	assert.Error(t, techRepo.RefreshTechnologies(context.Background()))
+
+	categories, err = skillRepo.GetSkillCategories(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, categories, 1)
+	tech, err := techRepo.GetTechnology(context.Background(), "Go")
+	assert.NoError(t, err)
+	assert.Equal(t, "go.svg", tech.Icon)
+}
+
+func TestGitHubSkillRepository_NotReady(t *testing.T) {
+	repo := &GitHubSkillRepository{
+		snapshot:     newSnapshotStore[[]models.SkillCategory]("skills"),
+		readyTimeout: 10 * time.Millisecond,
+	}
+
+	categories, err := repo.GetSkillCategories(context.Background())
+
+	assert.ErrorIs(t, err, ErrNotReady)
+	assert.Nil(t, categories)
+}
+
+// projectsLoadedAt scrapes the projects load timestamp from the metrics endpoint
+func projectsLoadedAt(t *testing.T) float64 {
+	t.Helper()
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	prefix := `website_repository_last_load_timestamp_seconds{repository="projects"} `
+	for _, line := range strings.Split(w.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, prefix); ok {
+			loadedAt, err := strconv.ParseFloat(value, 64)
+			require.NoError(t, err)
+			return loadedAt
+		}
+	}
+	t.Fatal("projects load metric not exported")
+	return 0
+}
+
+func TestGitHubProjectRepository_RecordsLoadsFromGitHub(t *testing.T) {
+	status := int32(http.StatusOK)
+	server := newContentServer(t, map[string]string{
+		"projects/projects.json": `{"projects":[{"id":1,"title":"Cache"}]}`,
+	}, &status)
+	githubClient := client.NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+
+	unloaded := time.Unix(1, 0)
+	metrics.RecordRepositoryLoad("projects", unloaded)
+
+	repo := NewGitHubProjectRepository(githubClient, NewInMemoryTechnologyRepository())
+	assert.Eventually(t, func() bool {
+		return projectsLoadedAt(t) > float64(unloaded.Unix())
+	}, time.Second, 10*time.Millisecond, "prewarm fetch is recorded")
+
+	// Reads served from the client cache are not loads
+	metrics.RecordRepositoryLoad("projects", unloaded)
+	_, err := repo.GetAllProjects(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, float64(unloaded.Unix()), projectsLoadedAt(t))
+
+	// Neither are failed refreshes
+	atomic.StoreInt32(&status, http.StatusUnauthorized)
+	githubClient.InvalidatePaths("projects/projects.json")
+	assert.Error(t, repo.RefreshProjects(context.Background()))
+	assert.Equal(t, float64(unloaded.Unix()), projectsLoadedAt(t))
+
+	atomic.StoreInt32(&status, http.StatusOK)
+	require.NoError(t, repo.RefreshProjects(context.Background()))
+	assert.InDelta(t, float64(time.Now().Unix()), projectsLoadedAt(t), 5)
+}
+
+func TestGitHubProjectRepository_Readiness(t *testing.T) {
+	status := int32(http.StatusServiceUnavailable)
+	var requests int32
+	server := newContentServer(t, map[string]string{
+		"projects/projects.json": `{"projects":[{"id":1,"title":"Cache"}]}`,
+	}, &status)
+	counting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(counting.Close)
+
+	newRepo := func(cacheTTL time.Duration) *GitHubProjectRepository {
+		githubClient := client.NewGitHubClient(&config.GitHubConfig{
+			Owner:      "test-owner",
+			Repository: "test-repo",
+			BaseURL:    counting.URL,
+			CacheTTL:   cacheTTL,
+		})
+		repo := &GitHubProjectRepository{
+			githubClient: githubClient,
+			techRepo:     NewInMemoryTechnologyRepository(),
+			fetched:      newSnapshotStore[models.ProjectsResponse]("projects"),
+		}
+		githubClient.OnFetch(projectsPath, repo.recordProjectsFetch)
+		return repo
+	}
+
+	t.Run("ready once a prewarm succeeds", func(t *testing.T) {
+		repo := newRepo(time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		repo.PrewarmCache(ctx)
+
+		select {
+		case <-repo.Ready():
+			t.Fatal("ready before projects loaded")
+		default:
+		}
+		assert.True(t, repo.LoadedAt().IsZero())
+
+		atomic.StoreInt32(&status, http.StatusOK)
+		t.Cleanup(func() { atomic.StoreInt32(&status, http.StatusServiceUnavailable) })
+		repo.PrewarmCache(context.Background())
+
+		select {
+		case <-repo.Ready():
+		default:
+			t.Fatal("not ready after a successful prewarm")
+		}
+		assert.False(t, repo.LoadedAt().IsZero())
+	})
+
+	t.Run("refreshes served from the cache are not loads", func(t *testing.T) {
+		atomic.StoreInt32(&status, http.StatusOK)
+		repo := newRepo(time.Hour)
+		require.NoError(t, repo.RefreshProjects(context.Background()))
+		loadedAt := repo.LoadedAt()
+		before := atomic.LoadInt32(&requests)
+
+		require.NoError(t, repo.RefreshProjects(context.Background()))
+
+		assert.Equal(t, before, atomic.LoadInt32(&requests))
+		assert.Equal(t, loadedAt, repo.LoadedAt())
+
+		// A failed refresh keeps the previous load time
+		atomic.StoreInt32(&status, http.StatusUnauthorized)
+		repo.githubClient.InvalidatePaths("projects/projects.json")
+		assert.Error(t, repo.RefreshProjects(context.Background()))
+		assert.Equal(t, loadedAt, repo.LoadedAt())
+	})
+
+	t.Run("TTL refreshes update the load time", func(t *testing.T) {
+		atomic.StoreInt32(&status, http.StatusOK)
+		repo := newRepo(time.Millisecond)
+		_, err := repo.GetAllProjects(context.Background())
+		require.NoError(t, err)
+		loadedAt := repo.LoadedAt()
+
+		time.Sleep(5 * time.Millisecond)
+		_, err = repo.GetAllProjects(context.Background())
+		require.NoError(t, err)
+
+		assert.True(t, repo.LoadedAt().After(loadedAt))
+	})
+}
+
+func TestGitHubPostRepository(t *testing.T) {
+	var requests int32
+	var hasPosts atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		switch {
+		case !hasPosts.Load():
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(r.URL.Path, "/contents/posts"):
+			json.NewEncoder(w).Encode([]client.GitHubDirectoryEntry{
+				{Name: "hello.md", Path: "posts/hello.md", Type: "file"},
+				{Name: "draft.md", Path: "posts/draft.md", Type: "file"},
+				{Name: "images", Path: "posts/images", Type: "dir"},
+			})
+		case strings.HasSuffix(r.URL.Path, "/contents/posts/hello.md"):
+			json.NewEncoder(w).Encode(client.GitHubFile{
+				Content:  base64.StdEncoding.EncodeToString([]byte("---\ntitle: Hello\ndate: 2024-03-01\n---\nBody")),
+				Encoding: "base64",
+			})
+		case strings.HasSuffix(r.URL.Path, "/contents/posts/draft.md"):
+			json.NewEncoder(w).Encode(client.GitHubFile{
+				Content:  base64.StdEncoding.EncodeToString([]byte("---\ntitle: Draft\ndraft: true\n---\nBody")),
+				Encoding: "base64",
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	githubClient := client.NewGitHubClient(&config.GitHubConfig{
+		Owner:      "test-owner",
+		Repository: "test-repo",
+		BaseURL:    server.URL,
+	})
+	repo := NewGitHubPostRepository(githubClient)
+
+	select {
+	case <-repo.Ready():
+	case <-time.After(time.Second):
+		t.Fatal("posts did not load")
+	}
+
+	// A content repository without a posts directory has no posts, and reads do not call GitHub
+	before := atomic.LoadInt32(&requests)
+	for range 3 {
+		posts, err := repo.GetAllPosts(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, posts)
+		_, err = repo.GetPostBySlug(context.Background(), "hello")
+		assert.ErrorIs(t, err, ErrPostNotFound)
+	}
+	assert.Equal(t, before, atomic.LoadInt32(&requests))
+
+	hasPosts.Store(true)
+	githubClient.InvalidatePaths("posts/hello.md")
+	require.NoError(t, repo.RefreshPosts(context.Background()))
+
+	posts, err := repo.GetAllPosts(context.Background())
+	require.NoError(t, err)
+	require.Len(t, posts, 1, "drafts are not listed")
+	assert.Equal(t, "hello", posts[0].Slug)
+
+	post, err := repo.GetPostBySlug(context.Background(), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", post.Title)
+}
+
+func TestGitHubPostRepository_NotReady(t *testing.T) {
+	repo := &GitHubPostRepository{
+		snapshot:     newSnapshotStore[[]*models.Post]("posts"),
+		readyTimeout: 10 * time.Millisecond,
+	}
+
+	posts, err := repo.GetAllPosts(context.Background())
+
+	assert.ErrorIs(t, err, ErrNotReady)
+	assert.Nil(t, posts)
+}
@@ -1,29 +1,49 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/benidevo/website/internal/models"
 )
 
 // ProjectRepository defines the interface for project data access
 type ProjectRepository interface {
 	// GetAllProjects returns all projects
-	GetAllProjects() ([]*models.Project, error)
+	GetAllProjects(ctx context.Context) ([]*models.Project, error)
+
+	// GetProjectBySlug returns a project by its slug
+	GetProjectBySlug(ctx context.Context, slug string) (*models.Project, error)
+}
+
+// PostRepository defines the interface for blog post data access
+type PostRepository interface {
+	// GetAllPosts returns all published posts, newest first
+	GetAllPosts(ctx context.Context) ([]*models.Post, error)
+
+	// GetPostBySlug returns a published post by its slug
+	GetPostBySlug(ctx context.Context, slug string) (*models.Post, error)
 }
 
 // TechnologyRepository defines the interface for technology data access
 type TechnologyRepository interface {
 	// GetTechnology returns a technology by name
-	GetTechnology(name string) (*models.Technology, error)
+	GetTechnology(ctx context.Context, name string) (*models.Technology, error)
 
 	// GetTechnologies returns multiple technologies by names
-	GetTechnologies(names []string) []models.Technology
+	GetTechnologies(ctx context.Context, names []string) []models.Technology
 
 	// GetAllTechnologies returns all available technologies
-	GetAllTechnologies() (map[string]models.Technology, error)
+	GetAllTechnologies(ctx context.Context) (map[string]models.Technology, error)
 }
 
 // SkillRepository defines the interface for skill data access
 type SkillRepository interface {
 	// GetSkillCategories returns all skill categories
-	GetSkillCategories() ([]models.SkillCategory, error)
+	GetSkillCategories(ctx context.Context) ([]models.SkillCategory, error)
+}
+
+// ProfileRepository defines the interface for site profile data access
+type ProfileRepository interface {
+	// GetProfile returns the profile of the person or team the site belongs to
+	GetProfile(ctx context.Context) (*models.Profile, error)
 }
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/benidevo/website/internal/models"
+)
+
+// InMemoryPostRepository implements PostRepository with in-memory data
+type InMemoryPostRepository struct {
+	posts map[string]*models.Post
+}
+
+// NewInMemoryPostRepository creates a new in-memory post repository
+func NewInMemoryPostRepository() *InMemoryPostRepository {
+	return &InMemoryPostRepository{
+		posts: make(map[string]*models.Post),
+	}
+}
+
+// GetAllPosts returns all published posts, newest first
+func (r *InMemoryPostRepository) GetAllPosts(ctx context.Context) ([]*models.Post, error) {
+	posts := make([]*models.Post, 0, len(r.posts))
+	for _, post := range r.posts {
+		posts = append(posts, post)
+	}
+	return publishedPosts(posts), nil
+}
+
+// GetPostBySlug returns a published post by its slug
+func (r *InMemoryPostRepository) GetPostBySlug(ctx context.Context, slug string) (*models.Post, error) {
+	post, exists := r.posts[slug]
+	if !exists || post.Draft {
+		return nil, fmt.Errorf("%w: %s", ErrPostNotFound, slug)
+	}
+	return post, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemoryPostRepository_GetAllPosts(t *testing.T) {
+	tests := []struct {
+		name  string
+		posts map[string]*models.Post
+		want  []string
+	}{
+		{
+			name: "returns published posts newest first",
+			posts: map[string]*models.Post{
+				"first":  {Slug: "first", PublishedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+				"second": {Slug: "second", PublishedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				"draft":  {Slug: "draft", Draft: true},
+			},
+			want: []string{"second", "first"},
+		},
+		{
+			name:  "returns empty slice when no posts",
+			posts: map[string]*models.Post{},
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &InMemoryPostRepository{
+				posts: tt.posts,
+			}
+
+			result, err := repo.GetAllPosts(context.Background())
+
+			assert.NoError(t, err)
+			slugs := make([]string, 0, len(result))
+			for _, post := range result {
+				slugs = append(slugs, post.Slug)
+			}
+			assert.Equal(t, tt.want, slugs)
+		})
+	}
+}
+
+func TestInMemoryPostRepository_GetPostBySlug(t *testing.T) {
+	repo := &InMemoryPostRepository{
+		posts: map[string]*models.Post{
+			"hello": {Slug: "hello", Title: "Hello"},
+			"draft": {Slug: "draft", Title: "Draft", Draft: true},
+		},
+	}
+
+	t.Run("returns post when found", func(t *testing.T) {
+		post, err := repo.GetPostBySlug(context.Background(), "hello")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello", post.Title)
+	})
+
+	t.Run("returns not found for drafts", func(t *testing.T) {
+		post, err := repo.GetPostBySlug(context.Background(), "draft")
+
+		assert.ErrorIs(t, err, ErrPostNotFound)
+		assert.Nil(t, post)
+	})
+
+	t.Run("returns not found for unknown slug", func(t *testing.T) {
+		post, err := repo.GetPostBySlug(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, ErrPostNotFound)
+		assert.Nil(t, post)
+	})
+}
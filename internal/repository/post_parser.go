@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/benidevo/website/internal/models"
+)
+
+// ErrPostNotFound is returned when no published post matches the requested slug
+var ErrPostNotFound = errors.New("post not found")
+
+const (
+	postsDirectory       = "posts"
+	postFileExtension    = ".md"
+	frontMatterDelimiter = "---"
+	postDateLayout       = "2006-01-02"
+)
+
+// parsePost parses a Markdown file with YAML front matter into a Post.
+// The slug defaults to the file name without its extension.
+func parsePost(filename, content string) (*models.Post, error) {
+	frontMatter, body, err := splitFrontMatter(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	var meta models.PostFrontMatter
+	if err := yaml.Unmarshal([]byte(frontMatter), &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse front matter in %s: %w", filename, err)
+	}
+
+	if meta.Title == "" {
+		return nil, fmt.Errorf("post %s is missing a title", filename)
+	}
+
+	slug := meta.Slug
+	if slug == "" {
+		slug = strings.TrimSuffix(path.Base(filename), postFileExtension)
+	}
+
+	var publishedAt time.Time
+	if meta.Date != "" {
+		publishedAt, err = time.Parse(postDateLayout, meta.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q in %s: %w", meta.Date, filename, err)
+		}
+	}
+
+	return &models.Post{
+		Slug:        slug,
+		Title:       meta.Title,
+		Summary:     meta.Summary,
+		PublishedAt: publishedAt,
+		Tags:        meta.Tags,
+		Draft:       meta.Draft,
+		Content:     body,
+	}, nil
+}
+
+// splitFrontMatter separates the front matter block from the Markdown body
+func splitFrontMatter(content string) (string, string, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(content, frontMatterDelimiter+"\n") {
+		return "", "", errors.New("missing front matter")
+	}
+
+	rest := content[len(frontMatterDelimiter)+1:]
+	end := strings.Index(rest, "\n"+frontMatterDelimiter)
+	if end == -1 {
+		return "", "", errors.New("unterminated front matter")
+	}
+
+	frontMatter := rest[:end]
+	body := strings.TrimPrefix(rest[end+len(frontMatterDelimiter)+1:], "\n")
+
+	return frontMatter, strings.TrimSpace(body), nil
+}
+
+// isPostFile reports whether a file name looks like a Markdown post
+func isPostFile(name string) bool {
+	return strings.HasSuffix(name, postFileExtension)
+}
+
+// publishedPosts drops drafts and sorts the remaining posts newest first
+func publishedPosts(posts []*models.Post) []*models.Post {
+	published := make([]*models.Post, 0, len(posts))
+	for _, post := range posts {
+		if !post.Draft {
+			published = append(published, post)
+		}
+	}
+
+	sort.SliceStable(published, func(i, j int) bool {
+		return published[i].PublishedAt.After(published[j].PublishedAt)
+	})
+
+	return published
+}
+
+// findPostBySlug returns the published post with the given slug
+func findPostBySlug(posts []*models.Post, slug string) (*models.Post, error) {
+	for _, post := range posts {
+		if post.Slug == slug && !post.Draft {
+			return post, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPostNotFound, slug)
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePost(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     *models.Post
+		wantErr  bool
+	}{
+		{
+			name:     "parses front matter and body",
+			filename: "hello-world.md",
+			content:  "---\ntitle: Hello World\nsummary: First post\ndate: 2024-03-01\ntags: [go, blog]\n---\n\n# Heading\n\nBody text.\n",
+			want: &models.Post{
+				Slug:        "hello-world",
+				Title:       "Hello World",
+				Summary:     "First post",
+				PublishedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				Tags:        []string{"go", "blog"},
+				Content:     "# Heading\n\nBody text.",
+			},
+		},
+		{
+			name:     "uses slug from front matter",
+			filename: "2024-03-01-post.md",
+			content:  "---\ntitle: Custom\nslug: custom-slug\n---\nBody",
+			want: &models.Post{
+				Slug:    "custom-slug",
+				Title:   "Custom",
+				Content: "Body",
+			},
+		},
+		{
+			name:     "handles windows line endings",
+			filename: "crlf.md",
+			content:  "---\r\ntitle: CRLF\r\n---\r\nBody\r\n",
+			want: &models.Post{
+				Slug:    "crlf",
+				Title:   "CRLF",
+				Content: "Body",
+			},
+		},
+		{
+			name:     "returns error when front matter is missing",
+			filename: "plain.md",
+			content:  "# Just markdown",
+			wantErr:  true,
+		},
+		{
+			name:     "returns error when front matter is unterminated",
+			filename: "broken.md",
+			content:  "---\ntitle: Broken\n",
+			wantErr:  true,
+		},
+		{
+			name:     "returns error when title is missing",
+			filename: "untitled.md",
+			content:  "---\nsummary: No title\n---\nBody",
+			wantErr:  true,
+		},
+		{
+			name:     "returns error on invalid date",
+			filename: "bad-date.md",
+			content:  "---\ntitle: Bad\ndate: yesterday\n---\nBody",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parsePost(tt.filename, tt.content)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestPublishedPosts(t *testing.T) {
+	older := &models.Post{Slug: "older", PublishedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	newer := &models.Post{Slug: "newer", PublishedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	draft := &models.Post{Slug: "draft", Draft: true, PublishedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	result := publishedPosts([]*models.Post{older, draft, newer})
+
+	assert.Equal(t, []*models.Post{newer, older}, result)
+}
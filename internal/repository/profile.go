@@ -0,0 +1,51 @@
+package repository
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/benidevo/website/internal/models"
+)
+
+// profilePath is the location of the site profile within the content source
+const profilePath = "profile/profile.json"
+
+// defaultProfileJSON is the site's own profile, served when no profile.json is available
+//
+//go:embed default_profile.json
+var defaultProfileJSON []byte
+
+// defaultProfile is parsed once at startup so a broken default fails every test run
+var defaultProfile = mustParseProfile(defaultProfileJSON)
+
+// DefaultProfile returns a copy of the built-in profile, used by the in-memory repository
+// and whenever the configured content source has no usable profile.json.
+// Slice fields are shared and must not be modified.
+func DefaultProfile() *models.Profile {
+	profile := *defaultProfile
+	return &profile
+}
+
+// mustParseProfile parses a profile that is compiled into the binary
+func mustParseProfile(content []byte) *models.Profile {
+	profile, err := parseProfile(content)
+	if err != nil {
+		panic(fmt.Sprintf("invalid built-in profile: %v", err))
+	}
+	return profile
+}
+
+// parseProfile decodes profile.json, which must at least name the site owner
+func parseProfile(content []byte) (*models.Profile, error) {
+	var profile models.Profile
+	if err := json.Unmarshal(content, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse profile.json: %w", err)
+	}
+
+	if profile.Name == "" {
+		return nil, fmt.Errorf("profile.json is missing a name")
+	}
+
+	return &profile, nil
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/benidevo/website/internal/models"
+)
+
+// InMemoryProfileRepository implements ProfileRepository with in-memory data
+type InMemoryProfileRepository struct {
+	profile models.Profile
+}
+
+// NewInMemoryProfileRepository creates a new in-memory profile repository holding the default profile
+func NewInMemoryProfileRepository() *InMemoryProfileRepository {
+	return &InMemoryProfileRepository{
+		profile: *DefaultProfile(),
+	}
+}
+
+// GetProfile returns a copy of the profile
+func (r *InMemoryProfileRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
+	profile := r.profile
+	return &profile, nil
+}
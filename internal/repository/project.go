@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/benidevo/website/internal/markdown"
+	"github.com/benidevo/website/internal/models"
+	"github.com/rs/zerolog/log"
+)
+
+// projectsPath is the location of the projects file within the content source
+const projectsPath = "projects/projects.json"
+
+// ErrProjectNotFound is returned when no project matches the requested ID or slug
+var ErrProjectNotFound = errors.New("project not found")
+
+// projectDateLayouts are the accepted formats for a project's started and ended dates
+var projectDateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+// parseProjectsData decodes the contents of projects.json
+func parseProjectsData(content []byte) (*models.ProjectsResponse, error) {
+	var projectsResponse models.ProjectsResponse
+	if err := json.Unmarshal(content, &projectsResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse projects.json: %w", err)
+	}
+	return &projectsResponse, nil
+}
+
+// projectTechnologies returns every known technology by name. It is called once per
+// load so that a technology repository that is not ready delays a request at most once;
+// projects are then built without technologies rather than failing.
+func projectTechnologies(ctx context.Context, techRepo TechnologyRepository) map[string]models.Technology {
+	technologies, err := techRepo.GetAllTechnologies(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Technologies unavailable, building projects without them")
+		return nil
+	}
+	return technologies
+}
+
+// newProject converts a projects.json entry into a Project, resolving its technologies from technologies.
+// The slug defaults to one derived from the title, or the ID when the title has no usable characters.
+func newProject(data models.ProjectData, technologies map[string]models.Technology) (*models.Project, error) {
+	startedAt, err := parseProjectDate(data.Started)
+	if err != nil {
+		return nil, fmt.Errorf("invalid started date for project %d: %w", data.ID, err)
+	}
+	endedAt, err := parseProjectDate(data.Ended)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ended date for project %d: %w", data.ID, err)
+	}
+
+	slug := data.Slug
+	if slug == "" {
+		slug = slugify(data.Title)
+	}
+	if slug == "" {
+		slug = strconv.Itoa(data.ID)
+	}
+
+	return &models.Project{
+		ID:              data.ID,
+		Slug:            slug,
+		Title:           data.Title,
+		Description:     data.Description,
+		LongDescription: data.LongDescription,
+		GitHubURL:       data.GitHubURL,
+		LiveURL:         data.LiveURL,
+		Language:        data.Language,
+		Technologies:    lookupTechnologies(technologies, data.Technologies),
+		Featured:        data.Featured,
+		Images:          data.Images,
+		StartedAt:       startedAt,
+		EndedAt:         endedAt,
+
+		DescriptionHTML:     markdown.Render(data.Description),
+		LongDescriptionHTML: markdown.Render(data.LongDescription),
+	}, nil
+}
+
+// lookupTechnologies returns the technologies with the given names, skipping unknown names
+func lookupTechnologies(technologies map[string]models.Technology, names []string) []models.Technology {
+	techs := make([]models.Technology, 0, len(names))
+	for _, name := range names {
+		if tech, exists := technologies[name]; exists {
+			techs = append(techs, tech)
+		}
+	}
+	return techs
+}
+
+// parseProjectDate parses a YYYY-MM-DD, YYYY-MM or YYYY date, returning nil for an empty value
+func parseProjectDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range projectDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("%q is not a YYYY-MM-DD, YYYY-MM or YYYY date", value)
+}
+
+// slugify lowercases title and joins its letters and digits with hyphens, e.g.
+// "Ascentio: Job Search" becomes "ascentio-job-search"
+func slugify(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+// findProjectBySlug returns the project with the given slug
+func findProjectBySlug(projects []*models.Project, slug string) (*models.Project, error) {
+	for _, project := range projects {
+		if project.Slug == slug {
+			return project, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrProjectNotFound, slug)
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/benidevo/website/internal/models"
 )
 
@@ -17,7 +18,7 @@ func NewInMemoryProjectRepository(techRepo TechnologyRepository) *InMemoryProjec
 }
 
 // GetAllProjects returns all projects
-func (r *InMemoryProjectRepository) GetAllProjects() ([]*models.Project, error) {
+func (r *InMemoryProjectRepository) GetAllProjects(ctx context.Context) ([]*models.Project, error) {
 	var projects []*models.Project
 	for _, project := range r.projects {
 		projects = append(projects, project)
@@ -25,6 +26,12 @@ func (r *InMemoryProjectRepository) GetAllProjects() ([]*models.Project, error)
 	return projects, nil
 }
 
+// GetProjectBySlug returns the project with the given slug
+func (r *InMemoryProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	projects, _ := r.GetAllProjects(ctx)
+	return findProjectBySlug(projects, slug)
+}
+
 // InMemorySkillRepository implements SkillRepository with in-memory data
 type InMemorySkillRepository struct {
 	skillCategories []models.SkillCategory
@@ -38,7 +45,7 @@ func NewInMemorySkillRepository(techRepo TechnologyRepository) *InMemorySkillRep
 }
 
 // GetSkillCategories returns all skill categories
-func (r *InMemorySkillRepository) GetSkillCategories() ([]models.SkillCategory, error) {
+func (r *InMemorySkillRepository) GetSkillCategories(ctx context.Context) ([]models.SkillCategory, error) {
 	// Return a copy to prevent external modification
 	categories := make([]models.SkillCategory, len(r.skillCategories))
 	copy(categories, r.skillCategories)
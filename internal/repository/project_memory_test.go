@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/benidevo/website/internal/models"
@@ -34,7 +35,7 @@ func TestInMemoryProjectRepository_GetAllProjects(t *testing.T) {
 				projects: tt.projects,
 			}
 
-			result, err := repo.GetAllProjects()
+			result, err := repo.GetAllProjects(context.Background())
 
 			assert.NoError(t, err)
 			assert.Len(t, result, tt.want)
@@ -69,7 +70,7 @@ func TestInMemorySkillRepository_GetSkillCategories(t *testing.T) {
 				skillCategories: tt.categories,
 			}
 
-			result, err := repo.GetSkillCategories()
+			result, err := repo.GetSkillCategories(context.Background())
 
 			assert.NoError(t, err)
 			assert.Len(t, result, tt.want)
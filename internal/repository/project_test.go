@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"html/template"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/models"
+)
+
+func TestNewProject(t *testing.T) {
+	t.Run("copies optional fields and parses dates", func(t *testing.T) {
+		project, err := newProject(models.ProjectData{
+			ID:              1,
+			Technologies:    []string{"Go", "Unknown"},
+			Slug:            "custom",
+			Title:           "Cache",
+			LongDescription: "More detail",
+			Images:          []models.ProjectImage{{URL: "/static/cache.png", Alt: "Dashboard"}},
+			Started:         "2023-04",
+			Ended:           "2024",
+		}, map[string]models.Technology{"Go": {Name: "Go", Icon: "go.svg"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, "custom", project.Slug)
+		assert.Equal(t, "More detail", project.LongDescription)
+		assert.Len(t, project.Images, 1)
+		assert.Equal(t, []models.Technology{{Name: "Go", Icon: "go.svg"}}, project.Technologies)
+		assert.Equal(t, time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC), *project.StartedAt)
+		assert.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), *project.EndedAt)
+	})
+
+	t.Run("derives the slug from the title", func(t *testing.T) {
+		project, err := newProject(models.ProjectData{ID: 2, Title: "Ascentio: Job Search!"}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ascentio-job-search", project.Slug)
+		assert.Nil(t, project.StartedAt)
+		assert.Nil(t, project.EndedAt)
+	})
+
+	t.Run("renders descriptions as sanitized Markdown", func(t *testing.T) {
+		project, err := newProject(models.ProjectData{
+			ID:              5,
+			Title:           "Cache",
+			Description:     "A **fast** cache",
+			LongDescription: "<script>alert(1)</script>*Why* it exists",
+		}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "A **fast** cache", project.Description, "the raw Markdown is kept for the API")
+		assert.Equal(t, template.HTML("<p>A <strong>fast</strong> cache</p>\n"), project.DescriptionHTML)
+		assert.NotContains(t, project.LongDescriptionHTML, "<script>")
+		assert.Contains(t, project.LongDescriptionHTML, "<em>Why</em> it exists")
+	})
+
+	t.Run("falls back to the ID for titles without letters or digits", func(t *testing.T) {
+		project, err := newProject(models.ProjectData{ID: 3, Title: "???"}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "3", project.Slug)
+	})
+
+	t.Run("rejects invalid dates", func(t *testing.T) {
+		_, err := newProject(models.ProjectData{ID: 4, Title: "Cache", Started: "April 2023"}, nil)
+
+		assert.ErrorContains(t, err, "invalid started date for project 4")
+	})
+}
+
+// unavailableTechnologyRepository counts lookups against a technology repository that never loads
+type unavailableTechnologyRepository struct {
+	allCalls   int32
+	namedCalls int32
+}
+
+func (r *unavailableTechnologyRepository) GetTechnology(ctx context.Context, name string) (*models.Technology, error) {
+	atomic.AddInt32(&r.namedCalls, 1)
+	return nil, ErrNotReady
+}
+
+func (r *unavailableTechnologyRepository) GetTechnologies(ctx context.Context, names []string) []models.Technology {
+	atomic.AddInt32(&r.namedCalls, 1)
+	return []models.Technology{}
+}
+
+func (r *unavailableTechnologyRepository) GetAllTechnologies(ctx context.Context) (map[string]models.Technology, error) {
+	atomic.AddInt32(&r.allCalls, 1)
+	return nil, ErrNotReady
+}
+
+func TestGetAllProjects_ResolvesTechnologiesOnce(t *testing.T) {
+	dir := t.TempDir()
+	writeContentFile(t, dir, "projects/projects.json", `{"projects": [
+		{"id": 1, "title": "One", "technologies": ["Go"]},
+		{"id": 2, "title": "Two", "technologies": ["Go"]},
+		{"id": 3, "title": "Three", "technologies": ["Redis"]}
+	]}`)
+	techRepo := &unavailableTechnologyRepository{}
+
+	projects, err := NewFileSystemProjectRepository(dir, techRepo).GetAllProjects(context.Background())
+
+	require.NoError(t, err)
+	assert.Len(t, projects, 3, "projects still render without technologies")
+	assert.Empty(t, projects[0].Technologies)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&techRepo.allCalls))
+	assert.Zero(t, atomic.LoadInt32(&techRepo.namedCalls))
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Cache", "cache"},
+		{"  Job Search -- API  ", "job-search-api"},
+		{"Go 1.24 Tools", "go-1-24-tools"},
+		{"Café Menü", "café-menü"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, slugify(tt.title))
+		})
+	}
+}
+
+func TestFindProjectBySlug(t *testing.T) {
+	projects := []*models.Project{{ID: 1, Slug: "cache"}, {ID: 2, Slug: "search"}}
+
+	project, err := findProjectBySlug(projects, "search")
+	require.NoError(t, err)
+	assert.Equal(t, 2, project.ID)
+
+	_, err = findProjectBySlug(projects, "missing")
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+	assert.ErrorContains(t, err, "missing")
+}
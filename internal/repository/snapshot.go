@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/benidevo/website/internal/metrics"
+)
+
+// ErrNotReady is returned when repository data has not finished loading in time
+var ErrNotReady = errors.New("repository data not loaded yet")
+
+const (
+	// defaultReadyTimeout bounds how long a request waits for the initial load
+	defaultReadyTimeout = 5 * time.Second
+
+	initialRetryDelay = time.Second
+	maxRetryDelay     = 5 * time.Minute
+)
+
+// snapshotStore holds an immutable value that is replaced atomically on every load.
+// Readers never observe a partially built value, and the ready channel is closed
+// once the first value has been stored.
+type snapshotStore[T any] struct {
+	name      string // Repository name reported in load metrics
+	current   atomic.Pointer[T]
+	loadedAt  atomic.Pointer[time.Time]
+	ready     chan struct{}
+	readyOnce sync.Once
+	timedOut  atomic.Bool // Set once a Wait has given up on the initial load
+}
+
+// newSnapshotStore creates an empty snapshot store for the named repository
+func newSnapshotStore[T any](name string) *snapshotStore[T] {
+	return &snapshotStore[T]{
+		name:  name,
+		ready: make(chan struct{}),
+	}
+}
+
+// Load returns the current snapshot, or nil before the first store
+func (s *snapshotStore[T]) Load() *T {
+	return s.current.Load()
+}
+
+// Store swaps in a new snapshot and marks the store as ready
+func (s *snapshotStore[T]) Store(value *T) {
+	now := time.Now()
+	s.current.Store(value)
+	s.loadedAt.Store(&now)
+	s.readyOnce.Do(func() { close(s.ready) })
+	metrics.RecordRepositoryLoad(s.name, now)
+}
+
+// Ready returns a channel that is closed once the first snapshot is stored
+func (s *snapshotStore[T]) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// LoadedAt returns when the current snapshot was stored, or the zero time before the first store
+func (s *snapshotStore[T]) LoadedAt() time.Time {
+	if loadedAt := s.loadedAt.Load(); loadedAt != nil {
+		return *loadedAt
+	}
+	return time.Time{}
+}
+
+// Wait returns the current snapshot, waiting up to timeout for the first store
+// or until ctx is done. Once one Wait has timed out, later calls return ErrNotReady
+// immediately until the first store, so a failing initial load costs one timeout
+// rather than one per read.
+func (s *snapshotStore[T]) Wait(ctx context.Context, timeout time.Duration) (*T, error) {
+	if value := s.current.Load(); value != nil {
+		return value, nil
+	}
+	if s.timedOut.Load() {
+		return nil, ErrNotReady
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-s.ready:
+		return s.current.Load(), nil
+	case <-timer.C:
+		s.timedOut.Store(true)
+		return nil, ErrNotReady
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// loadWithRetry calls load until it succeeds or ctx is done, backing off exponentially between attempts
+func loadWithRetry(ctx context.Context, load func(ctx context.Context) error, onError func(err error, retryIn time.Duration)) {
+	delay := initialRetryDelay
+	for {
+		err := load(ctx)
+		if err == nil {
+			return
+		}
+
+		onError(err, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
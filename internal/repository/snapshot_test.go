@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotStore_Wait(t *testing.T) {
+	t.Run("times out before first store", func(t *testing.T) {
+		store := newSnapshotStore[[]string]("test")
+
+		value, err := store.Wait(context.Background(), 10*time.Millisecond)
+
+		assert.ErrorIs(t, err, ErrNotReady)
+		assert.Nil(t, value)
+		assert.True(t, store.LoadedAt().IsZero())
+	})
+
+	t.Run("fails fast after the first timeout until a snapshot is stored", func(t *testing.T) {
+		store := newSnapshotStore[[]string]("test")
+
+		_, err := store.Wait(context.Background(), 10*time.Millisecond)
+		assert.ErrorIs(t, err, ErrNotReady)
+
+		start := time.Now()
+		_, err = store.Wait(context.Background(), time.Second)
+		assert.ErrorIs(t, err, ErrNotReady)
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+		store.Store(&[]string{"Go"})
+		value, err := store.Wait(context.Background(), time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Go"}, *value)
+	})
+
+	t.Run("unblocks when a snapshot is stored", func(t *testing.T) {
+		store := newSnapshotStore[[]string]("test")
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			store.Store(&[]string{"Go"})
+		}()
+
+		value, err := store.Wait(context.Background(), time.Second)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Go"}, *value)
+		assert.False(t, store.LoadedAt().IsZero())
+		select {
+		case <-store.Ready():
+		default:
+			t.Fatal("ready channel should be closed")
+		}
+	})
+
+	t.Run("concurrent readers and writers", func(t *testing.T) {
+		store := newSnapshotStore[map[string]int]("test")
+		var wg sync.WaitGroup
+
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				store.Store(&map[string]int{"value": i})
+			}(i)
+			go func() {
+				defer wg.Done()
+				if value, err := store.Wait(context.Background(), time.Second); err == nil {
+					_ = (*value)["value"]
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.NotNil(t, store.Load())
+	})
+}
+
+func TestLoadWithRetry(t *testing.T) {
+	attempts := 0
+	var retries []time.Duration
+
+	loadWithRetry(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if attempts < 2 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	}, func(err error, retryIn time.Duration) {
+		retries = append(retries, retryIn)
+	})
+
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, []time.Duration{initialRetryDelay}, retries)
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/benidevo/website/internal/models"
@@ -19,7 +20,7 @@ func NewInMemoryTechnologyRepository() *InMemoryTechnologyRepository {
 }
 
 // GetTechnology returns a technology by name
-func (r *InMemoryTechnologyRepository) GetTechnology(name string) (*models.Technology, error) {
+func (r *InMemoryTechnologyRepository) GetTechnology(ctx context.Context, name string) (*models.Technology, error) {
 	tech, exists := r.technologies[name]
 	if !exists {
 		return nil, fmt.Errorf("technology '%s' not found", name)
@@ -28,7 +29,7 @@ func (r *InMemoryTechnologyRepository) GetTechnology(name string) (*models.Techn
 }
 
 // GetTechnologies returns multiple technologies by names (optimized for batch lookups)
-func (r *InMemoryTechnologyRepository) GetTechnologies(names []string) []models.Technology {
+func (r *InMemoryTechnologyRepository) GetTechnologies(ctx context.Context, names []string) []models.Technology {
 	// Pre-allocate slice with exact capacity to avoid reallocations
 	techs := make([]models.Technology, 0, len(names))
 	for _, name := range names {
@@ -40,7 +41,7 @@ func (r *InMemoryTechnologyRepository) GetTechnologies(names []string) []models.
 }
 
 // GetAllTechnologies returns all available technologies
-func (r *InMemoryTechnologyRepository) GetAllTechnologies() (map[string]models.Technology, error) {
+func (r *InMemoryTechnologyRepository) GetAllTechnologies(ctx context.Context) (map[string]models.Technology, error) {
 	// Return a copy to prevent external modification
 	result := make(map[string]models.Technology)
 	for k, v := range r.technologies {
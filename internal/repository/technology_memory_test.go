@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/benidevo/website/internal/models"
@@ -41,7 +42,7 @@ func TestInMemoryTechnologyRepository_GetTechnology(t *testing.T) {
 				technologies: tt.technologies,
 			}
 
-			result, err := repo.GetTechnology(tt.techName)
+			result, err := repo.GetTechnology(context.Background(), tt.techName)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -87,7 +88,7 @@ func TestInMemoryTechnologyRepository_GetTechnologies(t *testing.T) {
 				technologies: tt.technologies,
 			}
 
-			result := repo.GetTechnologies(tt.techNames)
+			result := repo.GetTechnologies(context.Background(), tt.techNames)
 
 			assert.Len(t, result, len(tt.want))
 			for i, tech := range tt.want {
@@ -124,7 +125,7 @@ func TestInMemoryTechnologyRepository_GetAllTechnologies(t *testing.T) {
 				technologies: tt.technologies,
 			}
 
-			result, err := repo.GetAllTechnologies()
+			result, err := repo.GetAllTechnologies(context.Background())
 
 			assert.NoError(t, err)
 			assert.Len(t, result, tt.want)
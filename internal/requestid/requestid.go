@@ -0,0 +1,53 @@
+// Package requestid carries the identifier that correlates a request across logs,
+// error pages and calls to upstream services
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header is the HTTP header a request ID is read from and written to
+const Header = "X-Request-ID"
+
+// maxLength bounds the size of request IDs accepted from clients
+const maxLength = 128
+
+type contextKey struct{}
+
+// New generates a random request ID
+func New() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read never returns an error on supported platforms
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Valid reports whether id is safe to accept from a client and repeat in logs and
+// headers: non-empty, bounded in length and limited to letters, digits and -_.:
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NewContext returns a copy of ctx carrying id
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or "" if there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
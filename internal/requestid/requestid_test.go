@@ -0,0 +1,45 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	first, second := New(), New()
+
+	assert.Len(t, first, 32)
+	assert.True(t, Valid(first))
+	assert.NotEqual(t, first, second)
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{id: "3f2b9c1e-7d4a-4c1b-9e3f-0a1b2c3d4e5f", want: true},
+		{id: "edge:req_01.abc", want: true},
+		{id: "", want: false},
+		{id: strings.Repeat("a", maxLength+1), want: false},
+		{id: "has space", want: false},
+		{id: "line\nbreak", want: false},
+		{id: "<script>", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			assert.Equal(t, tt.want, Valid(tt.id))
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Empty(t, FromContext(context.Background()))
+
+	ctx := NewContext(context.Background(), "req-123")
+	assert.Equal(t, "req-123", FromContext(ctx))
+}
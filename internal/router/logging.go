@@ -0,0 +1,71 @@
+package router
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// loggingMiddleware writes one structured access log entry per request through the
+// application logger, so it follows LOG_LEVEL and the development console output.
+// Server errors are logged at error level, client errors at warn and the rest at info.
+func loggingMiddleware(c *gin.Context) {
+	start := time.Now()
+
+	c.Next()
+
+	status := c.Writer.Status()
+	event := accessLogEvent(status)
+	if !event.Enabled() {
+		return
+	}
+
+	bytes := c.Writer.Size()
+	if bytes < 0 {
+		bytes = 0
+	}
+
+	event.
+		Str("method", c.Request.Method).
+		Str("path", c.Request.URL.Path).
+		Str("query", c.Request.URL.RawQuery).
+		Int("status", status).
+		Dur("latency", time.Since(start)).
+		Int("bytes", bytes).
+		Str("client_ip", c.ClientIP()).
+		Str("user_agent", c.Request.UserAgent()).
+		Str("request_id", c.GetString(requestIDKey))
+
+	if errs := c.Errors.ByType(gin.ErrorTypePrivate); len(errs) > 0 {
+		event.Str("error", errs.String())
+	}
+
+	event.Msg("Request handled")
+}
+
+// accessLogEvent returns a log event at the level matching the response status
+func accessLogEvent(status int) *zerolog.Event {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return log.Error()
+	case status >= http.StatusBadRequest:
+		return log.Warn()
+	default:
+		return log.Info()
+	}
+}
+
+// init sends gin's own debug output, such as route registration, through the
+// application logger at debug level instead of straight to stdout
+func init() {
+	gin.DebugPrintRouteFunc = func(method, path, handler string, handlers int) {
+		log.Debug().Str("method", method).Str("path", path).Str("handler", handler).Int("handlers", handlers).Msg("Route registered")
+	}
+	gin.DebugPrintFunc = func(format string, values ...interface{}) {
+		log.Debug().Msgf(strings.TrimSuffix(format, "\n"), values...)
+	}
+}
@@ -0,0 +1,91 @@
+package router
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/requestid"
+)
+
+// captureLogs redirects the application logger into a buffer for the duration of the test
+func captureLogs(t *testing.T, level zerolog.Level) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	previousLogger, previousLevel := log.Logger, zerolog.GlobalLevel()
+	log.Logger = zerolog.New(&buf)
+	zerolog.SetGlobalLevel(level)
+	t.Cleanup(func() {
+		log.Logger = previousLogger
+		zerolog.SetGlobalLevel(previousLevel)
+	})
+
+	return &buf
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		status    int
+		wantLevel string
+	}{
+		{name: "success", status: http.StatusOK, wantLevel: "info"},
+		{name: "client error", status: http.StatusNotFound, wantLevel: "warn"},
+		{name: "server error", status: http.StatusBadGateway, wantLevel: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLogs(t, zerolog.InfoLevel)
+
+			router := gin.New()
+			router.Use(requestIDMiddleware, loggingMiddleware)
+			router.GET("/things/:id", func(c *gin.Context) {
+				c.String(tt.status, "hello")
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/things/42?page=2", nil)
+			req.Header.Set("User-Agent", "test-agent")
+			req.Header.Set(requestid.Header, "req-123")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, tt.wantLevel, entry["level"])
+			assert.Equal(t, "GET", entry["method"])
+			assert.Equal(t, "/things/42", entry["path"])
+			assert.Equal(t, "page=2", entry["query"])
+			assert.Equal(t, float64(tt.status), entry["status"])
+			assert.Equal(t, float64(len("hello")), entry["bytes"])
+			assert.Equal(t, "192.0.2.1", entry["client_ip"])
+			assert.Equal(t, "test-agent", entry["user_agent"])
+			assert.Equal(t, "req-123", entry["request_id"])
+			assert.Contains(t, entry, "latency")
+		})
+	}
+}
+
+func TestLoggingMiddlewareHonoursLevel(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	buf := captureLogs(t, zerolog.WarnLevel)
+
+	router := gin.New()
+	router.Use(loggingMiddleware)
+	router.GET("/", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,52 @@
+package router
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/benidevo/website/internal/metrics"
+)
+
+// unmatchedRoute labels requests that matched no route, keeping arbitrary paths out of metric labels
+const unmatchedRoute = "unmatched"
+
+// metricsMiddleware records the count and latency of every request by method, route and status
+func metricsMiddleware(c *gin.Context) {
+	start := time.Now()
+
+	c.Next()
+
+	route := c.FullPath()
+	if route == "" {
+		route = unmatchedRoute
+	}
+	metrics.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+}
+
+// metricsHandler serves the Prometheus metrics. When token is set, requests must
+// carry it as a bearer token in the Authorization header.
+func metricsHandler(token string) gin.HandlerFunc {
+	handler := metrics.Handler()
+
+	return func(c *gin.Context) {
+		if token != "" && !hasBearerToken(c.GetHeader("Authorization"), token) {
+			c.Header("WWW-Authenticate", `Bearer realm="metrics"`)
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+// hasBearerToken reports whether an Authorization header carries the expected bearer token
+func hasBearerToken(header, token string) bool {
+	scheme, value, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(value)), []byte(token)) == 1
+}
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "open without a token", wantStatus: http.StatusOK},
+		{name: "valid bearer token", token: "s3cret", authorization: "Bearer s3cret", wantStatus: http.StatusOK},
+		{name: "scheme is case-insensitive", token: "s3cret", authorization: "bearer s3cret", wantStatus: http.StatusOK},
+		{name: "missing token", token: "s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", token: "s3cret", authorization: "Bearer guess", wantStatus: http.StatusUnauthorized},
+		{name: "wrong scheme", token: "s3cret", authorization: "Basic s3cret", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(metricsMiddleware)
+			router.GET("/things/:id", func(c *gin.Context) {
+				c.String(http.StatusTeapot, "ok")
+			})
+			router.GET("/metrics", metricsHandler(tt.token))
+
+			// Generate a request so the HTTP metrics have a sample
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/things/42", nil))
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere/42", nil))
+
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				assert.Equal(t, `Bearer realm="metrics"`, w.Header().Get("WWW-Authenticate"))
+				return
+			}
+
+			body, err := io.ReadAll(w.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `website_http_requests_total{method="GET",route="/things/:id",status="418"}`)
+			assert.Contains(t, string(body), `website_http_requests_total{method="GET",route="unmatched",status="404"}`)
+			assert.Contains(t, string(body), `website_http_request_duration_seconds_bucket{method="GET",route="/things/:id",status="418"`)
+			assert.NotContains(t, string(body), "/nowhere/42")
+		})
+	}
+}
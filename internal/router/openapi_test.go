@@ -0,0 +1,58 @@
+package router
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/openapi"
+)
+
+// ginParam matches gin path parameters such as :id
+var ginParam = regexp.MustCompile(`:(\w+)`)
+
+// TestOpenAPIDocumentsRoutes checks that the OpenAPI document and the JSON routes
+// registered by SetupRouter describe the same set of endpoints
+func TestOpenAPIDocumentsRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Chdir("../..") // Templates are loaded relative to the repository root
+
+	engine, err := SetupRouter(&config.Config{Settings: &config.Settings{
+		Content: config.ContentConfig{Source: config.ContentSourceMemory},
+	}})
+	require.NoError(t, err)
+
+	var registered []string
+	for _, route := range engine.Routes() {
+		if !isJSONRoute(route.Path) {
+			continue
+		}
+		registered = append(registered, route.Method+" "+ginParam.ReplaceAllString(route.Path, "{$1}"))
+	}
+
+	var documented []string
+	for path, item := range openapi.Spec().Paths {
+		if item.Get != nil {
+			documented = append(documented, "GET "+path)
+		}
+	}
+
+	sort.Strings(registered)
+	sort.Strings(documented)
+	assert.Equal(t, documented, registered)
+}
+
+// isJSONRoute reports whether a route serves JSON that the OpenAPI document must describe
+func isJSONRoute(path string) bool {
+	switch path {
+	case "/livez", "/readyz", "/health":
+		return true
+	}
+	return strings.HasPrefix(path, "/api/")
+}
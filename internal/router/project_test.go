@@ -0,0 +1,286 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/config"
+)
+
+// newProjectContentRouter serves the site from a filesystem content directory holding
+// projects.json and any extra content files
+func newProjectContentRouter(t *testing.T, projectsJSON string, extraFiles ...map[string]string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"projects/projects.json":         projectsJSON,
+		"technologies/technologies.json": `{"technologies": {"Go": {"name": "Go", "icon": "/static/go.svg"}}}`,
+		"skills/skills.json":             `{"skill_categories": []}`,
+	}
+	for _, extra := range extraFiles {
+		for name, content := range extra {
+			files[name] = content
+		}
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	t.Chdir("../..") // Templates are loaded relative to the repository root
+
+	engine, err := SetupRouter(&config.Config{Settings: &config.Settings{
+		Content: config.ContentConfig{Source: config.ContentSourceFilesystem, Directory: dir},
+	}})
+	require.NoError(t, err)
+	return engine
+}
+
+func TestProjectPage(t *testing.T) {
+	engine := newProjectContentRouter(t, `{"projects": [
+		{
+			"id": 1,
+			"title": "Cache Service",
+			"description": "A *distributed* cache",
+			"long_description": "Built to shave latency off [hot reads](https://example.com/reads).",
+			"github_url": "https://github.com/example/cache",
+			"live_url": "https://cache.example.com",
+			"language": "Go",
+			"technologies": ["Go"],
+			"images": [{"url": "/static/cache.png", "alt": "Cache dashboard", "caption": "The dashboard"}],
+			"started": "2023-04",
+			"ended": "2024-02-10"
+		},
+		{"id": 2, "slug": "queue", "title": "Queue", "language": "Go", "technologies": ["Go"]},
+		{"id": 3, "title": "Website", "language": "TypeScript"}
+	]}`)
+
+	t.Run("renders the project", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/projects/cache-service", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		assert.Contains(t, body, "<h1 class=\"text-h2 text-primary mb-4\">Cache Service</h1>")
+		assert.Contains(t, body, "A <em>distributed</em> cache")
+		assert.Contains(t, body, `Built to shave latency off <a href="https://example.com/reads" rel="nofollow noopener" target="_blank">hot reads</a>.`)
+		assert.Contains(t, body, `<img src="/static/cache.png" alt="Cache dashboard"`)
+		assert.Contains(t, body, "The dashboard")
+		assert.Contains(t, body, "April 2023")
+		assert.Contains(t, body, "February 2024")
+		assert.Contains(t, body, `href="https://cache.example.com"`)
+		assert.Contains(t, body, `href="https://github.com/example/cache"`)
+		assert.Contains(t, body, `href="/projects/queue"`, "related projects are linked")
+		assert.NotContains(t, body, `href="/projects/website"`, "unrelated projects are left out")
+	})
+
+	t.Run("unknown slug renders the 404 page", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/projects/missing", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestProjectPage_OngoingProject(t *testing.T) {
+	engine := newProjectContentRouter(t, `{"projects": [{"id": 1, "slug": "cache", "title": "Cache", "started": "2024"}]}`)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/projects/cache", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Present")
+	assert.NotContains(t, w.Body.String(), "Related Projects")
+}
+
+func TestProjectsIndex(t *testing.T) {
+	engine := newProjectContentRouter(t, `{"projects": [
+		{"id": 1, "slug": "cache", "title": "Cache", "language": "Go", "technologies": ["Go"], "started": "2022", "ended": "2023"},
+		{"id": 2, "slug": "website", "title": "Website", "language": "TypeScript", "started": "2024-03", "ended": "2024-09"},
+		{"id": 3, "slug": "notes", "title": "Notes", "language": "Go"}
+	]}`)
+
+	get := func(target string, htmx bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if htmx {
+			req.Header.Set("HX-Request", "true")
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		wantSlugs []string
+		skipSlugs []string
+	}{
+		{name: "lists every project", target: "/projects", wantSlugs: []string{"cache", "website", "notes"}},
+		{name: "filters by technology", target: "/projects?technology=go", wantSlugs: []string{"cache"}, skipSlugs: []string{"website", "notes"}},
+		{name: "filters by language", target: "/projects?language=Go", wantSlugs: []string{"cache", "notes"}, skipSlugs: []string{"website"}},
+		{name: "filters by year", target: "/projects?year=2023", wantSlugs: []string{"cache"}, skipSlugs: []string{"website", "notes"}},
+		{name: "combines filters", target: "/projects?language=Go&year=2024", skipSlugs: []string{"cache", "website", "notes"}},
+		{name: "ignores an invalid year", target: "/projects?year=soon", wantSlugs: []string{"cache", "website", "notes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := get(tt.target, false)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			for _, slug := range tt.wantSlugs {
+				assert.Contains(t, w.Body.String(), `href="/projects/`+slug+`"`)
+			}
+			for _, slug := range tt.skipSlugs {
+				assert.NotContains(t, w.Body.String(), `href="/projects/`+slug+`"`)
+			}
+		})
+	}
+
+	t.Run("renders the full page with filter options", func(t *testing.T) {
+		w := get("/projects?year=2024", false)
+
+		body := w.Body.String()
+		assert.Contains(t, body, "<html")
+		assert.Contains(t, body, `<option value="2024" selected>2024</option>`)
+		assert.Contains(t, body, `<option value="2022">2022</option>`)
+		assert.Contains(t, body, `<option value="TypeScript">TypeScript</option>`)
+		assert.Equal(t, "HX-Request, HX-History-Restore-Request", w.Header().Get("Vary"))
+	})
+
+	t.Run("HTMX requests receive only the card list", func(t *testing.T) {
+		w := get("/projects?language=TypeScript", true)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		assert.NotContains(t, body, "<html")
+		assert.NotContains(t, body, "<select")
+		assert.Contains(t, body, `id="project-list"`)
+		assert.Contains(t, body, `href="/projects/website"`)
+		assert.NotContains(t, body, `href="/projects/cache"`)
+	})
+
+	t.Run("HTMX history restores receive the full page", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/projects?language=TypeScript", nil)
+		req.Header.Set("HX-Request", "true")
+		req.Header.Set("HX-History-Restore-Request", "true")
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		body := w.Body.String()
+		assert.Contains(t, body, "<html")
+		assert.Contains(t, body, `<option value="TypeScript" selected>TypeScript</option>`)
+		assert.Contains(t, body, `href="/projects/website"`)
+		assert.NotContains(t, body, `href="/projects/cache"`)
+	})
+
+	t.Run("reports when nothing matches", func(t *testing.T) {
+		w := get("/projects?technology=Rust", true)
+
+		assert.Contains(t, w.Body.String(), "No projects match these filters.")
+	})
+}
+
+func TestHomePage_LinksToAllProjects(t *testing.T) {
+	tests := []struct {
+		name     string
+		projects string
+		wantLink bool
+	}{
+		{
+			name:     "three projects fit on the home page",
+			projects: `{"projects": [{"id": 1, "title": "One"}, {"id": 2, "title": "Two"}, {"id": 3, "title": "Three"}]}`,
+		},
+		{
+			name:     "more projects link to the index",
+			projects: `{"projects": [{"id": 1, "title": "One"}, {"id": 2, "title": "Two"}, {"id": 3, "title": "Three"}, {"id": 4, "title": "Four"}]}`,
+			wantLink: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := newProjectContentRouter(t, tt.projects)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			if tt.wantLink {
+				assert.Contains(t, w.Body.String(), "View all 4 projects")
+			} else {
+				assert.NotContains(t, w.Body.String(), "View all")
+			}
+		})
+	}
+}
+
+func TestProjectPages_SanitizeProjectMarkdown(t *testing.T) {
+	engine := newProjectContentRouter(t, `{"projects": [
+		{
+			"id": 1,
+			"slug": "evil",
+			"title": "Evil",
+			"description": "Hi <img src=x onerror=alert(1)> [click](javascript:alert(1))",
+			"long_description": "<script>alert(document.cookie)</script><a href=\"https://example.com\" onclick=\"alert(1)\">ok</a>"
+		}
+	]}`)
+
+	for _, target := range []string{"/", "/projects", "/projects/evil"} {
+		t.Run(target, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			body := w.Body.String()
+			assert.NotContains(t, body, "onerror")
+			assert.NotContains(t, body, "javascript:alert")
+			assert.NotContains(t, body, "alert(document.cookie)")
+			assert.NotContains(t, body, `onclick="alert(1)"`)
+			assert.Contains(t, body, "click")
+		})
+	}
+}
+
+func TestPageCopyComesFromProfile(t *testing.T) {
+	engine := newProjectContentRouter(t, `{"projects": []}`, map[string]string{
+		"profile/profile.json": `{
+			"name": "Jane Doe",
+			"blog_description": "Jane writes about compilers.",
+			"projects_description": "Things Jane has shipped."
+		}`,
+	})
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{target: "/blog", want: "Jane writes about compilers."},
+		{target: "/projects", want: "Things Jane has shipped."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			require.Equal(t, http.StatusOK, w.Code)
+			body := w.Body.String()
+			assert.Contains(t, body, `<meta name="description" content="`+tt.want+`">`)
+			assert.Contains(t, body, `<p class="text-lg text-neutral">`+tt.want+`</p>`)
+			assert.NotContains(t, body, "Benjamin Idewor", "the default profile is not used")
+		})
+	}
+}
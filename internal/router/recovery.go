@@ -0,0 +1,149 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/handlers"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/requestid"
+)
+
+// globalErrorHandler is a Gin middleware that recovers from panics and handles internal server errors
+// by rendering a 500 error page.
+//
+// It ensures that any unhandled errors or panics result in a consistent error
+// response to the client, unless the handler already started writing its own
+// response, in which case the status and headers can no longer be changed.
+// It is registered after the compression middleware so the error response is
+// written while the compressed writer is still open.
+func globalErrorHandler(c *gin.Context) {
+	tracker := &responseTracker{ResponseWriter: c.Writer}
+	c.Writer = tracker
+
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		// net/http uses ErrAbortHandler to abort a response silently
+		if recovered == http.ErrAbortHandler {
+			panic(recovered)
+		}
+
+		err := panicError(recovered)
+		logger := log.Ctx(c.Request.Context())
+
+		if isConnectionLost(err) {
+			logger.Warn().Err(err).Str("path", c.Request.URL.Path).Msg("Client connection lost")
+			c.Abort()
+			return
+		}
+
+		logger.Error().
+			Err(err).
+			Str("method", c.Request.Method).
+			Str("path", c.Request.URL.Path).
+			Str("stack", string(debug.Stack())).
+			Msg("Recovered from panic")
+
+		_ = c.Error(err)
+		renderServerError(c, tracker)
+	}()
+
+	c.Next()
+
+	if len(c.Errors) > 0 || c.Writer.Status() == http.StatusInternalServerError {
+		renderServerError(c, tracker)
+	}
+}
+
+// renderServerError aborts the request and writes a 500 response: the JSON envelope
+// for API routes, otherwise in the format the client accepts. The response is left
+// untouched if it has already been started.
+func renderServerError(c *gin.Context, tracker *responseTracker) {
+	c.Abort()
+
+	if tracker.started() {
+		log.Ctx(c.Request.Context()).Warn().
+			Str("path", c.Request.URL.Path).
+			Int("status", c.Writer.Status()).
+			Msg("Response already started, not writing error page")
+		return
+	}
+
+	if handlers.IsAPIRequest(c) || c.NegotiateFormat(gin.MIMEHTML, gin.MIMEJSON) == gin.MIMEJSON {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:     models.APIError{Code: models.ErrorCodeInternal, Message: "internal server error"},
+			RequestID: requestid.FromContext(c.Request.Context()),
+		})
+		return
+	}
+	c.HTML(http.StatusInternalServerError, "500", serverErrorPageData(c))
+}
+
+// serverErrorPageData returns the data for the 500 page, including the request ID
+// users can quote when reporting the problem. The profile is left empty so the page
+// renders without depending on a content source that may be the cause of the error.
+func serverErrorPageData(c *gin.Context) models.ErrorPageData {
+	return models.ErrorPageData{
+		Title:       http.StatusText(http.StatusInternalServerError),
+		CurrentYear: time.Now().Year(),
+		Profile:     &models.Profile{},
+		RequestID:   requestid.FromContext(c.Request.Context()),
+	}
+}
+
+// panicError converts a recovered panic value of any type into an error
+func panicError(recovered interface{}) error {
+	if err, ok := recovered.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+	return fmt.Errorf("panic: %v", recovered)
+}
+
+// isConnectionLost reports whether err was caused by the client going away mid-response,
+// in which case nothing more can be written
+func isConnectionLost(err error) bool {
+	return errors.Is(err, syscall.EPIPE) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// responseTracker records whether a handler has started writing the response. The
+// underlying writer cannot always tell, because the gzip writer buffers the body
+// before anything reaches the connection.
+type responseTracker struct {
+	gin.ResponseWriter
+	wrote bool
+}
+
+func (w *responseTracker) Write(data []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseTracker) WriteString(s string) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *responseTracker) WriteHeaderNow() {
+	w.wrote = true
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *responseTracker) Flush() {
+	w.wrote = true
+	w.ResponseWriter.Flush()
+}
+
+// started reports whether the status line, headers or any of the body have been written
+func (w *responseTracker) started() bool {
+	return w.wrote || w.ResponseWriter.Written()
+}
@@ -0,0 +1,271 @@
+package router
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/requestid"
+)
+
+// recordingRenderer captures the data passed to HTML templates
+type recordingRenderer struct {
+	data interface{}
+}
+
+func (r *recordingRenderer) Instance(name string, data interface{}) render.Render {
+	r.data = data
+	return render.Data{ContentType: "text/html; charset=utf-8", Data: []byte(name)}
+}
+
+func newRecoveryRouter(handler gin.HandlerFunc, middleware ...gin.HandlerFunc) *gin.Engine {
+	router := gin.New()
+	router.HTMLRender = &recordingRenderer{}
+	router.Use(requestIDMiddleware)
+	router.Use(middleware...)
+	router.Use(globalErrorHandler)
+	router.GET("/", handler)
+	router.GET("/api/v1/projects", handler)
+	return router
+}
+
+func TestGlobalErrorHandlerRecoversAnyPanicValue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "string", value: "boom", want: "panic: boom"},
+		{name: "error", value: errors.New("failed"), want: "panic: failed"},
+		{name: "integer", value: 42, want: "panic: 42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLogs(t, zerolog.InfoLevel)
+
+			router := newRecoveryRouter(func(c *gin.Context) {
+				panic(tt.value)
+			})
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+			assert.Equal(t, "500", w.Body.String())
+
+			var entry map[string]interface{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, "error", entry["level"])
+			assert.Equal(t, tt.want, entry["error"])
+			assert.Contains(t, entry["stack"], "runtime/debug.Stack")
+			assert.NotEmpty(t, entry["request_id"])
+		})
+	}
+}
+
+func TestGlobalErrorHandlerNegotiatesFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		path        string
+		accept      string
+		contentType string
+	}{
+		{name: "no accept header", path: "/", contentType: "text/html"},
+		{name: "browser", path: "/", accept: "text/html,application/xhtml+xml,*/*;q=0.8", contentType: "text/html"},
+		{name: "json client", path: "/", accept: "application/json", contentType: "application/json"},
+		{name: "api client accepting anything", path: "/api/v1/projects", accept: "*/*", contentType: "application/json"},
+		{name: "api route from a browser", path: "/api/v1/projects", accept: "text/html,*/*;q=0.8", contentType: "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRecoveryRouter(func(c *gin.Context) {
+				panic("boom")
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set(requestid.Header, "req-json")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusInternalServerError, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), tt.contentType)
+			if tt.contentType == "application/json" {
+				assert.JSONEq(t, `{"error":{"code":"internal_error","message":"internal server error"},"request_id":"req-json"}`, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGlobalErrorHandlerDoesNotOverwriteStartedResponse(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		handler    gin.HandlerFunc
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "handler wrote its own error",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "custom"})
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"error":"custom"}`,
+		},
+		{
+			name: "panic after partial write",
+			handler: func(c *gin.Context) {
+				c.String(http.StatusOK, "partial")
+				panic("boom")
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   "partial",
+		},
+		{
+			name: "error after aborting with status",
+			handler: func(c *gin.Context) {
+				c.AbortWithStatus(http.StatusServiceUnavailable)
+				_ = c.Error(errors.New("unavailable"))
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRecoveryRouter(tt.handler)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func TestGlobalErrorHandlerWithCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		handler  gin.HandlerFunc
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "panic before writing",
+			handler:  func(c *gin.Context) { panic("boom") },
+			wantCode: http.StatusInternalServerError,
+			wantBody: "500",
+		},
+		{
+			name: "panic after buffered write",
+			handler: func(c *gin.Context) {
+				c.String(http.StatusOK, "partial")
+				panic("boom")
+			},
+			wantCode: http.StatusOK,
+			wantBody: "partial",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRecoveryRouter(tt.handler, compressionMiddleware())
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept-Encoding", "gzip")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+			reader, err := gzip.NewReader(w.Body)
+			require.NoError(t, err)
+			body, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBody, string(body))
+		})
+	}
+}
+
+func TestGlobalErrorHandlerConnectionLost(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	buf := captureLogs(t, zerolog.InfoLevel)
+
+	router := newRecoveryRouter(func(c *gin.Context) {
+		panic(fmt.Errorf("write response: %w", syscall.EPIPE))
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Empty(t, w.Body.String())
+	assert.Contains(t, buf.String(), "Client connection lost")
+	assert.NotContains(t, buf.String(), "stack")
+}
+
+func TestGlobalErrorHandlerRepanicsAbortHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := newRecoveryRouter(func(c *gin.Context) {
+		panic(http.ErrAbortHandler)
+	})
+
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func TestPanicError(t *testing.T) {
+	sentinel := errors.New("sentinel")
+
+	assert.ErrorIs(t, panicError(sentinel), sentinel)
+	assert.EqualError(t, panicError("text"), "panic: text")
+	assert.EqualError(t, panicError(nil), "panic: <nil>")
+}
+
+func TestGlobalErrorHandlerIncludesRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	renderer := &recordingRenderer{}
+	router := gin.New()
+	router.HTMLRender = renderer
+	router.Use(requestIDMiddleware, globalErrorHandler)
+	router.GET("/", func(c *gin.Context) {
+		c.Status(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(requestid.Header, "req-500")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	data, ok := renderer.data.(models.ErrorPageData)
+	require.True(t, ok)
+	assert.Equal(t, "req-500", data.RequestID)
+	assert.NotNil(t, data.Profile, "templates need a profile to render")
+}
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/requestid"
+)
+
+// requestIDKey is the gin context key holding the request ID
+const requestIDKey = "request_id"
+
+// requestIDMiddleware assigns every request an ID, reusing a well-formed X-Request-ID
+// sent by the client or a proxy and generating one otherwise. The ID is stored in the
+// gin context and the request context, attached to a request-scoped logger available
+// through log.Ctx, and echoed in the response headers.
+func requestIDMiddleware(c *gin.Context) {
+	id := c.GetHeader(requestid.Header)
+	if !requestid.Valid(id) {
+		id = requestid.New()
+	}
+
+	c.Set(requestIDKey, id)
+	c.Header(requestid.Header, id)
+
+	logger := log.With().Str("request_id", id).Logger()
+	ctx := requestid.NewContext(c.Request.Context(), id)
+	c.Request = c.Request.WithContext(logger.WithContext(ctx))
+
+	c.Next()
+}
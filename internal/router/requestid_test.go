@@ -0,0 +1,63 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/requestid"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		incoming string
+		wantSame bool
+	}{
+		{name: "generated when missing"},
+		{name: "accepted when well formed", incoming: "edge-4f1c9a", wantSame: true},
+		{name: "replaced when malformed", incoming: "bad id\r\nX-Injected: 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLogs(t, zerolog.InfoLevel)
+
+			var ginID, ctxID string
+			router := gin.New()
+			router.Use(requestIDMiddleware)
+			router.GET("/", func(c *gin.Context) {
+				ginID = c.GetString(requestIDKey)
+				ctxID = requestid.FromContext(c.Request.Context())
+				log.Ctx(c.Request.Context()).Info().Msg("handling")
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(requestid.Header, tt.incoming)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			id := w.Header().Get(requestid.Header)
+			require.True(t, requestid.Valid(id))
+			assert.Equal(t, id, ginID)
+			assert.Equal(t, id, ctxID)
+			assert.Contains(t, buf.String(), `"request_id":"`+id+`"`)
+			if tt.wantSame {
+				assert.Equal(t, tt.incoming, id)
+			} else {
+				assert.NotEqual(t, tt.incoming, id)
+			}
+		})
+	}
+}
@@ -2,13 +2,11 @@ package router
 
 import (
 	"html/template"
-	"net/http"
 	"time"
 
 	"github.com/gin-contrib/gzip"
 	"github.com/gin-contrib/multitemplate"
 	"github.com/gin-gonic/gin"
-	"github.com/rs/zerolog/log"
 
 	"github.com/benidevo/website/internal/config"
 	"github.com/benidevo/website/internal/handlers"
@@ -23,6 +21,12 @@ func createMultiTemplateRenderer() multitemplate.Renderer {
 		"eq": func(a, b interface{}) bool {
 			return a == b
 		},
+		"formatDate": func(t time.Time) string {
+			return t.Format("January 2, 2006")
+		},
+		"formatMonth": func(t time.Time) string {
+			return t.Format("January 2006")
+		},
 	}
 
 	// Create templates with base layout, partials, and page content
@@ -31,8 +35,46 @@ func createMultiTemplateRenderer() multitemplate.Renderer {
 		"web/templates/layouts/base.html",
 		"web/templates/partials/header.html",
 		"web/templates/partials/footer.html",
+		"web/templates/partials/project_card.html",
 		"web/templates/pages/home.html")
 
+	renderer.AddFromFilesFuncs("blog", funcMap,
+		"web/templates/blog.html",
+		"web/templates/layouts/base.html",
+		"web/templates/partials/header.html",
+		"web/templates/partials/footer.html",
+		"web/templates/pages/blog.html")
+
+	renderer.AddFromFilesFuncs("post", funcMap,
+		"web/templates/post.html",
+		"web/templates/layouts/base.html",
+		"web/templates/partials/header.html",
+		"web/templates/partials/footer.html",
+		"web/templates/pages/post.html")
+
+	renderer.AddFromFilesFuncs("projects", funcMap,
+		"web/templates/projects.html",
+		"web/templates/layouts/base.html",
+		"web/templates/partials/header.html",
+		"web/templates/partials/footer.html",
+		"web/templates/partials/project_card.html",
+		"web/templates/partials/project_list.html",
+		"web/templates/pages/projects.html")
+
+	// Rendered on its own for HTMX requests that refresh the filtered project list
+	renderer.AddFromFilesFuncs("project_list", funcMap,
+		"web/templates/project_list.html",
+		"web/templates/partials/project_card.html",
+		"web/templates/partials/project_list.html")
+
+	renderer.AddFromFilesFuncs("project", funcMap,
+		"web/templates/project.html",
+		"web/templates/layouts/base.html",
+		"web/templates/partials/header.html",
+		"web/templates/partials/footer.html",
+		"web/templates/partials/project_card.html",
+		"web/templates/pages/project.html")
+
 	renderer.AddFromFilesFuncs("404", funcMap,
 		"web/templates/404.html",
 		"web/templates/layouts/base.html",
@@ -57,53 +99,45 @@ func SetupRouter(cfg *config.Config) (*gin.Engine, error) {
 		return nil, err
 	}
 
-	handlers := handlers.SetupHandlers(services)
+	handlers := handlers.SetupHandlers(cfg, services)
 
-	router := gin.Default()
+	router := gin.New()
 
-	router.Use(globalErrorHandler)
+	router.Use(requestIDMiddleware)
+	router.Use(loggingMiddleware)
+	router.Use(tracingMiddleware)
+	router.Use(metricsMiddleware)
 	router.Use(compressionMiddleware())
+	router.Use(globalErrorHandler)
 
 	router.HTMLRender = createMultiTemplateRenderer()
 	router.Static("/static", "./web/static")
 
 	router.GET("/", handlers.HomeHandler.HomePage)
+	router.GET("/blog", handlers.BlogHandler.Index)
+	router.GET("/blog/:slug", handlers.BlogHandler.Show)
+	router.GET("/projects", handlers.ProjectHandler.Index)
+	router.GET("/projects/:slug", handlers.ProjectHandler.Show)
 
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{
-			"status":    "ok",
-			"timestamp": time.Now().UTC(),
-		})
-	})
+	router.POST("/webhooks/github", handlers.WebhookHandler.GitHub)
 
-	router.NoRoute(func(c *gin.Context) {
-		c.HTML(http.StatusNotFound, "404", nil)
-	})
+	api := router.Group("/api/v1")
+	api.GET("/projects", handlers.APIHandler.ListProjects)
+	api.GET("/projects/:id", handlers.APIHandler.GetProject)
+	api.GET("/skills", handlers.APIHandler.ListSkills)
+	api.GET("/technologies", handlers.APIHandler.ListTechnologies)
+	api.GET("/openapi.json", handlers.APIHandler.OpenAPI)
 
-	return router, nil
-}
+	router.GET("/metrics", metricsHandler(cfg.Settings.Metrics.Token))
 
-// globalErrorHandler is a Gin middleware that recovers from panics and handles internal server errors
-// by rendering a 500 error page.
-//
-// It ensures that any unhandled errors or panics result in a
-// consistent error response to the client.
-func globalErrorHandler(c *gin.Context) {
-	defer func() {
-		if err := recover(); err != nil {
-			log.Error().Err(err.(error)).Msg("Recovered from panic")
-
-			c.HTML(http.StatusInternalServerError, "500", nil)
-			c.Abort()
-		}
-	}()
-
-	c.Next()
-
-	if len(c.Errors) > 0 || c.Writer.Status() == http.StatusInternalServerError {
-		c.HTML(http.StatusInternalServerError, "500", nil)
-		c.Abort()
-	}
+	router.GET("/livez", handlers.HealthHandler.Livez)
+	router.GET("/readyz", handlers.HealthHandler.Readyz)
+	// Kept for existing uptime checks
+	router.GET("/health", handlers.HealthHandler.Livez)
+
+	router.NoRoute(handlers.ErrorHandler.NotFound)
+
+	return router, nil
 }
 
 // compressionMiddleware returns a Gin middleware handler that applies gzip compression
@@ -0,0 +1,55 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/benidevo/website/internal/tracing"
+)
+
+// tracingMiddleware starts a server span for every request, continuing any trace
+// propagated by the caller, and makes it the parent of spans created while handling it
+func tracingMiddleware(c *gin.Context) {
+	ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+	route := c.FullPath()
+	spanName := c.Request.Method
+	if route != "" {
+		spanName = c.Request.Method + " " + route
+	}
+
+	ctx, span := tracing.Tracer().Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.HTTPRequestMethodKey.String(c.Request.Method),
+		semconv.URLPath(c.Request.URL.Path),
+		semconv.UserAgentOriginal(c.Request.UserAgent()),
+	)
+	if id := c.GetString(requestIDKey); id != "" {
+		span.SetAttributes(attribute.String("request.id", id))
+	}
+	if route != "" {
+		span.SetAttributes(semconv.HTTPRoute(route))
+	}
+
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+
+	status := c.Writer.Status()
+	span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+	if status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+	}
+	for _, err := range c.Errors {
+		span.RecordError(err.Err)
+	}
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+)
+
+const (
+	projectsPath     = "projects/projects.json"
+	technologiesPath = "technologies/technologies.json"
+	skillsPath       = "skills/skills.json"
+	profilePath      = "profile/profile.json"
+	postsDirectory   = "posts/"
+)
+
+type projectRefresher interface {
+	RefreshProjects(ctx context.Context) error
+}
+
+type technologyRefresher interface {
+	RefreshTechnologies(ctx context.Context) error
+}
+
+type skillRefresher interface {
+	RefreshSkills(ctx context.Context) error
+}
+
+type profileRefresher interface {
+	RefreshProfile(ctx context.Context) error
+}
+
+type postRefresher interface {
+	RefreshPosts(ctx context.Context) error
+}
+
+// contentCache holds upstream content shared by the repositories, implemented by *client.GitHubClient
+type contentCache interface {
+	InvalidatePaths(paths ...string) int
+}
+
+// ContentService keeps cached content in sync with upstream changes.
+type ContentService struct {
+	projectRepo    repository.ProjectRepository
+	technologyRepo repository.TechnologyRepository
+	skillRepo      repository.SkillRepository
+	postRepo       repository.PostRepository
+	profileRepo    repository.ProfileRepository
+	cache          contentCache // nil unless content comes from GitHub
+}
+
+// NewContentService creates a new content service
+func NewContentService(
+	projectRepo repository.ProjectRepository,
+	technologyRepo repository.TechnologyRepository,
+	skillRepo repository.SkillRepository,
+	postRepo repository.PostRepository,
+	profileRepo repository.ProfileRepository,
+	cache contentCache,
+) *ContentService {
+	return &ContentService{
+		projectRepo:    projectRepo,
+		technologyRepo: technologyRepo,
+		skillRepo:      skillRepo,
+		postRepo:       postRepo,
+		profileRepo:    profileRepo,
+		cache:          cache,
+	}
+}
+
+// ChangedPaths returns the sorted, de-duplicated list of files touched by a push event
+func ChangedPaths(event *models.GitHubPushEvent) []string {
+	seen := make(map[string]struct{})
+	for _, commit := range event.Commits {
+		for _, group := range [][]string{commit.Added, commit.Removed, commit.Modified} {
+			for _, p := range group {
+				seen[p] = struct{}{}
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(seen))
+	for p := range seen {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// IsDefaultBranchPush reports whether a push event targets the repository's default branch,
+// which is the only branch the GitHub content repositories read from
+func IsDefaultBranchPush(event *models.GitHubPushEvent) bool {
+	if event.Repository.DefaultBranch == "" {
+		return true
+	}
+	return event.Ref == "refs/heads/"+event.Repository.DefaultBranch
+}
+
+// InvalidatePaths drops cached copies of the given paths from the shared upstream cache
+// and returns the number of cache entries removed
+func (s *ContentService) InvalidatePaths(paths []string) int {
+	if s.cache == nil {
+		return 0
+	}
+	return s.cache.InvalidatePaths(paths...)
+}
+
+// Refresh reloads the repositories whose content files are among the given paths.
+// Projects embed technologies, so a technology change also reloads projects.
+func (s *ContentService) Refresh(ctx context.Context, paths []string) {
+	technologiesChanged := containsPath(paths, technologiesPath)
+
+	if technologiesChanged {
+		if refresher, ok := s.technologyRepo.(technologyRefresher); ok {
+			if err := refresher.RefreshTechnologies(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to refresh technologies")
+			}
+		}
+	}
+
+	if containsPath(paths, skillsPath) {
+		if refresher, ok := s.skillRepo.(skillRefresher); ok {
+			if err := refresher.RefreshSkills(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to refresh skills")
+			}
+		}
+	}
+
+	if containsPath(paths, profilePath) {
+		if refresher, ok := s.profileRepo.(profileRefresher); ok {
+			if err := refresher.RefreshProfile(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to refresh profile")
+			}
+		}
+	}
+
+	if containsPathUnder(paths, postsDirectory) {
+		if refresher, ok := s.postRepo.(postRefresher); ok {
+			if err := refresher.RefreshPosts(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to refresh posts")
+			}
+		}
+	}
+
+	if technologiesChanged || containsPath(paths, projectsPath) {
+		if refresher, ok := s.projectRepo.(projectRefresher); ok {
+			if err := refresher.RefreshProjects(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to refresh projects")
+			}
+		}
+	}
+
+	log.Info().Strs("paths", paths).Msg("Content refreshed")
+}
+
+// containsPath reports whether target is in paths, ignoring a leading slash
+func containsPath(paths []string, target string) bool {
+	for _, p := range paths {
+		if strings.TrimPrefix(p, "/") == target {
+			return true
+		}
+	}
+	return false
+}
+
+// containsPathUnder reports whether any of paths is inside directory, ignoring a leading slash
+func containsPathUnder(paths []string, directory string) bool {
+	for _, p := range paths {
+		if strings.HasPrefix(strings.TrimPrefix(p, "/"), directory) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockContentCache struct {
+	invalidated [][]string
+}
+
+func (m *mockContentCache) InvalidatePaths(paths ...string) int {
+	m.invalidated = append(m.invalidated, paths)
+	return len(paths)
+}
+
+type mockCachingProjectRepository struct {
+	mockProjectRepository
+	refreshed int
+}
+
+func (m *mockCachingProjectRepository) RefreshProjects(ctx context.Context) error {
+	m.refreshed++
+	return nil
+}
+
+type mockCachingSkillRepository struct {
+	mockSkillRepository
+	refreshed int
+}
+
+func (m *mockCachingSkillRepository) RefreshSkills(ctx context.Context) error {
+	m.refreshed++
+	return nil
+}
+
+type mockCachingProfileRepository struct {
+	mockProfileRepository
+	refreshed int
+}
+
+func (m *mockCachingProfileRepository) RefreshProfile(ctx context.Context) error {
+	m.refreshed++
+	return nil
+}
+
+type mockCachingPostRepository struct {
+	mockPostRepository
+	refreshed int
+}
+
+func (m *mockCachingPostRepository) RefreshPosts(ctx context.Context) error {
+	m.refreshed++
+	return nil
+}
+
+func TestChangedPaths(t *testing.T) {
+	event := &models.GitHubPushEvent{
+		Commits: []models.GitHubCommit{
+			{Added: []string{"posts/new.md"}, Modified: []string{"projects/projects.json"}},
+			{Removed: []string{"posts/old.md"}, Modified: []string{"projects/projects.json"}},
+		},
+	}
+
+	assert.Equal(t, []string{"posts/new.md", "posts/old.md", "projects/projects.json"}, ChangedPaths(event))
+}
+
+func TestIsDefaultBranchPush(t *testing.T) {
+	tests := []struct {
+		name  string
+		event models.GitHubPushEvent
+		want  bool
+	}{
+		{
+			name:  "push to default branch",
+			event: models.GitHubPushEvent{Ref: "refs/heads/main", Repository: models.GitHubEventRepository{DefaultBranch: "main"}},
+			want:  true,
+		},
+		{
+			name:  "push to feature branch",
+			event: models.GitHubPushEvent{Ref: "refs/heads/draft", Repository: models.GitHubEventRepository{DefaultBranch: "main"}},
+			want:  false,
+		},
+		{
+			name:  "unknown default branch",
+			event: models.GitHubPushEvent{Ref: "refs/heads/main"},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsDefaultBranchPush(&tt.event))
+		})
+	}
+}
+
+func TestContentService_InvalidateAndRefresh(t *testing.T) {
+	projectRepo := &mockCachingProjectRepository{}
+	skillRepo := &mockCachingSkillRepository{}
+	profileRepo := &mockCachingProfileRepository{}
+	postRepo := &mockCachingPostRepository{}
+	cache := &mockContentCache{}
+	service := NewContentService(projectRepo, nil, skillRepo, postRepo, profileRepo, cache)
+
+	// The repositories share one cache, so it is invalidated once
+	removed := service.InvalidatePaths([]string{"skills/skills.json", "technologies/technologies.json"})
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, [][]string{{"skills/skills.json", "technologies/technologies.json"}}, cache.invalidated)
+
+	// Without an upstream cache there is nothing to invalidate
+	assert.Zero(t, NewContentService(projectRepo, nil, skillRepo, postRepo, profileRepo, nil).InvalidatePaths([]string{"skills/skills.json"}))
+
+	service.Refresh(context.Background(), []string{"skills/skills.json"})
+	assert.Equal(t, 1, skillRepo.refreshed)
+	assert.Equal(t, 0, projectRepo.refreshed)
+
+	service.Refresh(context.Background(), []string{"projects/projects.json"})
+	assert.Equal(t, 1, skillRepo.refreshed)
+	assert.Equal(t, 1, projectRepo.refreshed)
+	assert.Equal(t, 0, profileRepo.refreshed)
+
+	service.Refresh(context.Background(), []string{"/profile/profile.json"})
+	assert.Equal(t, 1, profileRepo.refreshed)
+	assert.Equal(t, 0, postRepo.refreshed)
+
+	service.Refresh(context.Background(), []string{"posts/hello-world.md"})
+	assert.Equal(t, 1, postRepo.refreshed)
+	assert.Equal(t, 1, projectRepo.refreshed)
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/benidevo/website/internal/client"
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/models"
+)
+
+// Readiness statuses
+const (
+	statusReady    = "ready"
+	statusNotReady = "not_ready"
+)
+
+// upstreamMonitor reports on the GitHub API, implemented by *client.GitHubClient
+type upstreamMonitor interface {
+	Health() client.UpstreamHealth
+	RateLimit() client.RateLimitState
+}
+
+// loadTracker is implemented by repositories that load their content in the background
+type loadTracker interface {
+	Ready() <-chan struct{}
+	LoadedAt() time.Time
+}
+
+// HealthService reports whether the instance is warm enough to serve traffic
+type HealthService struct {
+	source       string
+	contentDir   string
+	upstream     upstreamMonitor // nil unless content comes from GitHub
+	repositories map[string]interface{}
+	now          func() time.Time
+}
+
+// NewHealthService creates a health service for the given content source. Repositories that
+// load in the background (see loadTracker) are reported individually; others are ignored.
+func NewHealthService(source, contentDir string, upstream upstreamMonitor, repositories map[string]interface{}) *HealthService {
+	return &HealthService{
+		source:       source,
+		contentDir:   contentDir,
+		upstream:     upstream,
+		repositories: repositories,
+		now:          time.Now,
+	}
+}
+
+// Readiness checks the content source and every background-loaded repository. A repository
+// that has not loaded yet fails the check; an unreachable or rate limited GitHub only
+// degrades it, since content already loaded can still be served.
+func (h *HealthService) Readiness() *models.Readiness {
+	now := h.now()
+
+	checks := map[string]models.HealthCheck{
+		"content_source": h.checkContentSource(now),
+	}
+	for name, repo := range h.repositories {
+		if tracker, ok := repo.(loadTracker); ok {
+			checks[name] = checkLoaded(tracker, now)
+		}
+	}
+
+	readiness := &models.Readiness{
+		Status:    statusReady,
+		Ready:     true,
+		Checks:    checks,
+		Timestamp: now.UTC(),
+	}
+	for _, check := range checks {
+		if check.Status == models.CheckFailing {
+			readiness.Status = statusNotReady
+			readiness.Ready = false
+			break
+		}
+	}
+	return readiness
+}
+
+// checkContentSource checks that the configured content source can be read
+func (h *HealthService) checkContentSource(now time.Time) models.HealthCheck {
+	switch h.source {
+	case config.ContentSourceGitHub:
+		if h.upstream == nil {
+			return models.HealthCheck{Status: models.CheckFailing, Message: "GitHub client not configured"}
+		}
+		return checkUpstream(h.upstream, now)
+	case config.ContentSourceFilesystem:
+		info, err := os.Stat(h.contentDir)
+		if err != nil {
+			return models.HealthCheck{Status: models.CheckFailing, Message: fmt.Sprintf("content directory unavailable: %v", err)}
+		}
+		if !info.IsDir() {
+			return models.HealthCheck{Status: models.CheckFailing, Message: fmt.Sprintf("content path %s is not a directory", h.contentDir)}
+		}
+		return models.HealthCheck{Status: models.CheckOK, Message: "filesystem"}
+	default:
+		return models.HealthCheck{Status: models.CheckOK, Message: h.source}
+	}
+}
+
+// checkUpstream reports on the GitHub API from the outcome of recent requests, without
+// sending any so that frequent probes do not use up the rate limit
+func checkUpstream(upstream upstreamMonitor, now time.Time) models.HealthCheck {
+	rateLimit := upstream.RateLimit()
+	check := upstreamStatus(upstream.Health(), rateLimit, now)
+	check.RateLimit = rateLimitReport(rateLimit)
+	return check
+}
+
+// upstreamStatus turns the GitHub client's health and rate limit into a check status
+func upstreamStatus(health client.UpstreamHealth, rateLimit client.RateLimitState, now time.Time) models.HealthCheck {
+	if now.Before(rateLimit.BlockedUntil) {
+		return models.HealthCheck{
+			Status:  models.CheckDegraded,
+			Message: "GitHub rate limited until " + rateLimit.BlockedUntil.UTC().Format(time.RFC3339),
+		}
+	}
+
+	switch {
+	case health.Reachable():
+		return models.HealthCheck{Status: models.CheckOK, Message: "github"}
+	case health.LastFailure.IsZero():
+		return models.HealthCheck{Status: models.CheckDegraded, Message: "no GitHub requests completed yet"}
+	default:
+		return models.HealthCheck{Status: models.CheckDegraded, Message: "GitHub unreachable: " + health.LastError}
+	}
+}
+
+// rateLimitReport converts the quota GitHub last reported, or returns nil before it has reported one
+func rateLimitReport(state client.RateLimitState) *models.RateLimit {
+	if state.UpdatedAt.IsZero() {
+		return nil
+	}
+
+	report := &models.RateLimit{
+		Limit:     state.Limit,
+		Remaining: state.Remaining,
+		Used:      state.Used,
+		Reset:     state.Reset.UTC(),
+		UpdatedAt: state.UpdatedAt.UTC(),
+	}
+	if !state.BlockedUntil.IsZero() {
+		blockedUntil := state.BlockedUntil.UTC()
+		report.BlockedUntil = &blockedUntil
+	}
+	return report
+}
+
+// checkLoaded reports whether a repository has loaded and how long ago it last did
+func checkLoaded(tracker loadTracker, now time.Time) models.HealthCheck {
+	select {
+	case <-tracker.Ready():
+	default:
+		return models.HealthCheck{Status: models.CheckFailing, Message: "not loaded yet"}
+	}
+
+	loadedAt := tracker.LoadedAt().UTC()
+	age := now.Sub(loadedAt).Seconds()
+	return models.HealthCheck{
+		Status:     models.CheckOK,
+		LoadedAt:   &loadedAt,
+		AgeSeconds: &age,
+	}
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/benidevo/website/internal/client"
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/models"
+)
+
+type fakeLoadTracker struct {
+	ready    chan struct{}
+	loadedAt time.Time
+}
+
+func newFakeLoadTracker(loadedAt time.Time) *fakeLoadTracker {
+	tracker := &fakeLoadTracker{ready: make(chan struct{}), loadedAt: loadedAt}
+	if !loadedAt.IsZero() {
+		close(tracker.ready)
+	}
+	return tracker
+}
+
+func (f *fakeLoadTracker) Ready() <-chan struct{} { return f.ready }
+func (f *fakeLoadTracker) LoadedAt() time.Time    { return f.loadedAt }
+
+type fakeUpstream struct {
+	health    client.UpstreamHealth
+	rateLimit client.RateLimitState
+}
+
+func (f *fakeUpstream) Health() client.UpstreamHealth    { return f.health }
+func (f *fakeUpstream) RateLimit() client.RateLimitState { return f.rateLimit }
+
+func TestHealthService_Readiness(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	loaded := now.Add(-90 * time.Second)
+	reachable := &fakeUpstream{health: client.UpstreamHealth{LastSuccess: loaded}}
+
+	tests := []struct {
+		name         string
+		source       string
+		contentDir   string
+		upstream     upstreamMonitor
+		repositories map[string]interface{}
+		wantReady    bool
+		wantChecks   map[string]string
+	}{
+		{
+			name:     "github with loaded repositories",
+			source:   config.ContentSourceGitHub,
+			upstream: reachable,
+			repositories: map[string]interface{}{
+				"skills":       newFakeLoadTracker(loaded),
+				"technologies": newFakeLoadTracker(loaded),
+				"posts":        struct{}{},
+			},
+			wantReady:  true,
+			wantChecks: map[string]string{"content_source": models.CheckOK, "skills": models.CheckOK, "technologies": models.CheckOK},
+		},
+		{
+			name:     "repository still loading",
+			source:   config.ContentSourceGitHub,
+			upstream: reachable,
+			repositories: map[string]interface{}{
+				"skills":       newFakeLoadTracker(loaded),
+				"technologies": newFakeLoadTracker(time.Time{}),
+			},
+			wantReady:  false,
+			wantChecks: map[string]string{"content_source": models.CheckOK, "skills": models.CheckOK, "technologies": models.CheckFailing},
+		},
+		{
+			name:   "github unreachable after loading",
+			source: config.ContentSourceGitHub,
+			upstream: &fakeUpstream{health: client.UpstreamHealth{
+				LastSuccess: loaded,
+				LastFailure: now,
+				LastError:   errors.New("connection refused").Error(),
+			}},
+			repositories: map[string]interface{}{"skills": newFakeLoadTracker(loaded)},
+			wantReady:    true,
+			wantChecks:   map[string]string{"content_source": models.CheckDegraded, "skills": models.CheckOK},
+		},
+		{
+			name:       "github rate limited",
+			source:     config.ContentSourceGitHub,
+			upstream:   &fakeUpstream{rateLimit: client.RateLimitState{BlockedUntil: now.Add(time.Minute)}},
+			wantReady:  true,
+			wantChecks: map[string]string{"content_source": models.CheckDegraded},
+		},
+		{
+			name:       "github without client",
+			source:     config.ContentSourceGitHub,
+			wantReady:  false,
+			wantChecks: map[string]string{"content_source": models.CheckFailing},
+		},
+		{
+			name:       "filesystem directory present",
+			source:     config.ContentSourceFilesystem,
+			contentDir: t.TempDir(),
+			wantReady:  true,
+			wantChecks: map[string]string{"content_source": models.CheckOK},
+		},
+		{
+			name:       "filesystem directory missing",
+			source:     config.ContentSourceFilesystem,
+			contentDir: filepath.Join(t.TempDir(), "missing"),
+			wantReady:  false,
+			wantChecks: map[string]string{"content_source": models.CheckFailing},
+		},
+		{
+			name:       "memory",
+			source:     config.ContentSourceMemory,
+			wantReady:  true,
+			wantChecks: map[string]string{"content_source": models.CheckOK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewHealthService(tt.source, tt.contentDir, tt.upstream, tt.repositories)
+			service.now = func() time.Time { return now }
+
+			readiness := service.Readiness()
+
+			assert.Equal(t, tt.wantReady, readiness.Ready)
+			if tt.wantReady {
+				assert.Equal(t, statusReady, readiness.Status)
+			} else {
+				assert.Equal(t, statusNotReady, readiness.Status)
+			}
+
+			statuses := make(map[string]string, len(readiness.Checks))
+			for name, check := range readiness.Checks {
+				statuses[name] = check.Status
+			}
+			assert.Equal(t, tt.wantChecks, statuses)
+		})
+	}
+}
+
+func TestHealthService_ReportsRateLimit(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Minute)
+
+	t.Run("includes the quota GitHub last reported", func(t *testing.T) {
+		upstream := &fakeUpstream{
+			health: client.UpstreamHealth{LastSuccess: now},
+			rateLimit: client.RateLimitState{
+				Limit:     5000,
+				Remaining: 4200,
+				Used:      800,
+				Reset:     reset,
+				UpdatedAt: now,
+			},
+		}
+		service := NewHealthService(config.ContentSourceGitHub, "", upstream, nil)
+		service.now = func() time.Time { return now }
+
+		check := service.Readiness().Checks["content_source"]
+
+		assert.Equal(t, models.CheckOK, check.Status)
+		assert.Equal(t, &models.RateLimit{Limit: 5000, Remaining: 4200, Used: 800, Reset: reset, UpdatedAt: now}, check.RateLimit)
+	})
+
+	t.Run("includes when requests resume while rate limited", func(t *testing.T) {
+		blockedUntil := now.Add(time.Minute)
+		upstream := &fakeUpstream{rateLimit: client.RateLimitState{
+			Limit:        5000,
+			Reset:        reset,
+			BlockedUntil: blockedUntil,
+			UpdatedAt:    now,
+		}}
+		service := NewHealthService(config.ContentSourceGitHub, "", upstream, nil)
+		service.now = func() time.Time { return now }
+
+		check := service.Readiness().Checks["content_source"]
+
+		assert.Equal(t, models.CheckDegraded, check.Status)
+		require.NotNil(t, check.RateLimit)
+		assert.Zero(t, check.RateLimit.Remaining)
+		assert.Equal(t, &blockedUntil, check.RateLimit.BlockedUntil)
+	})
+
+	t.Run("omitted before GitHub has reported a quota", func(t *testing.T) {
+		service := NewHealthService(config.ContentSourceGitHub, "", &fakeUpstream{}, nil)
+		service.now = func() time.Time { return now }
+
+		assert.Nil(t, service.Readiness().Checks["content_source"].RateLimit)
+	})
+}
+
+func TestHealthService_ReportsLoadAge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	loaded := now.Add(-90 * time.Second)
+
+	service := NewHealthService(config.ContentSourceMemory, "", nil, map[string]interface{}{
+		"skills": newFakeLoadTracker(loaded),
+	})
+	service.now = func() time.Time { return now }
+
+	check := service.Readiness().Checks["skills"]
+
+	require.NotNil(t, check.LoadedAt)
+	require.NotNil(t, check.AgeSeconds)
+	assert.Equal(t, loaded, *check.LoadedAt)
+	assert.Equal(t, 90.0, *check.AgeSeconds)
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/markdown"
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+)
+
+// PostService provides methods to read blog posts and render their Markdown content.
+type PostService struct {
+	postRepo repository.PostRepository
+}
+
+// NewPostService creates a new post service
+func NewPostService(postRepo repository.PostRepository) *PostService {
+	return &PostService{
+		postRepo: postRepo,
+	}
+}
+
+// GetPosts returns all published posts, newest first
+func (p *PostService) GetPosts(ctx context.Context) []*models.Post {
+	posts, err := p.postRepo.GetAllPosts(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get posts")
+		return []*models.Post{}
+	}
+	return posts
+}
+
+// GetPost returns a single post with its Markdown content rendered to sanitized HTML
+func (p *PostService) GetPost(ctx context.Context, slug string) (*models.Post, error) {
+	post, err := p.postRepo.GetPostBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := *post
+	rendered.HTML = markdown.RenderPost(post.Content)
+	return &rendered, nil
+}
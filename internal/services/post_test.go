@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPostRepository struct {
+	posts []*models.Post
+	err   error
+}
+
+func (m *mockPostRepository) GetAllPosts(ctx context.Context) ([]*models.Post, error) {
+	return m.posts, m.err
+}
+
+func (m *mockPostRepository) GetPostBySlug(ctx context.Context, slug string) (*models.Post, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	for _, post := range m.posts {
+		if post.Slug == slug {
+			return post, nil
+		}
+	}
+	return nil, repository.ErrPostNotFound
+}
+
+func TestPostService_GetPosts(t *testing.T) {
+	tests := []struct {
+		name  string
+		posts []*models.Post
+		err   error
+		want  int
+	}{
+		{
+			name:  "returns posts successfully",
+			posts: []*models.Post{{Slug: "one"}, {Slug: "two"}},
+			want:  2,
+		},
+		{
+			name: "returns empty slice on error",
+			err:  errors.New("github error"),
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewPostService(&mockPostRepository{posts: tt.posts, err: tt.err})
+
+			result := service.GetPosts(context.Background())
+
+			assert.Len(t, result, tt.want)
+		})
+	}
+}
+
+func TestPostService_GetPost(t *testing.T) {
+	original := &models.Post{Slug: "hello", Title: "Hello", Content: "Some *emphasis*"}
+	service := NewPostService(&mockPostRepository{posts: []*models.Post{original}})
+
+	t.Run("renders markdown content", func(t *testing.T) {
+		post, err := service.GetPost(context.Background(), "hello")
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(post.HTML), "<em>emphasis</em>")
+		assert.Empty(t, original.HTML, "repository post should not be mutated")
+	})
+
+	t.Run("sanitizes raw HTML", func(t *testing.T) {
+		unsafe := &models.Post{Slug: "unsafe", Content: "Hi <script>alert(1)</script><img src=x onerror=\"alert(1)\">"}
+		service := NewPostService(&mockPostRepository{posts: []*models.Post{unsafe}})
+
+		post, err := service.GetPost(context.Background(), "unsafe")
+
+		assert.NoError(t, err)
+		assert.NotContains(t, string(post.HTML), "<script")
+		assert.NotContains(t, string(post.HTML), "onerror")
+	})
+
+	t.Run("propagates not found", func(t *testing.T) {
+		post, err := service.GetPost(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, repository.ErrPostNotFound)
+		assert.Nil(t, post)
+	})
+}
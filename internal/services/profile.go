@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+)
+
+// ProfileService provides the site profile shown on every page.
+type ProfileService struct {
+	profileRepo repository.ProfileRepository
+}
+
+// NewProfileService creates a new profile service
+func NewProfileService(profileRepo repository.ProfileRepository) *ProfileService {
+	return &ProfileService{
+		profileRepo: profileRepo,
+	}
+}
+
+// GetProfile returns the site profile, or the built-in default profile if it cannot
+// be loaded so pages still render with the site's identity
+func (p *ProfileService) GetProfile(ctx context.Context) *models.Profile {
+	profile, err := p.profileRepo.GetProfile(ctx)
+	if err != nil {
+		// Not loaded yet is expected at startup, and a missing profile.json means the
+		// default profile is wanted, so neither is worth an error on every page view
+		event := log.Ctx(ctx).Error()
+		if errors.Is(err, repository.ErrNotReady) || errors.Is(err, fs.ErrNotExist) {
+			event = log.Ctx(ctx).Debug()
+		}
+		event.Err(err).Msg("Failed to get profile, using the default profile")
+		return repository.DefaultProfile()
+	}
+	return profile
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockProfileRepository struct {
+	profile *models.Profile
+	err     error
+}
+
+func (m *mockProfileRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
+	return m.profile, m.err
+}
+
+func TestProfileService_GetProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *models.Profile
+		err       error
+		want      string
+		wantLevel string // Level of the fallback log entry, empty when nothing is logged
+	}{
+		{
+			name:    "returns profile successfully",
+			profile: &models.Profile{Name: "Jane Doe"},
+			want:    "Jane Doe",
+		},
+		{
+			name:      "returns the default profile on error",
+			err:       errors.New("github error"),
+			want:      repository.DefaultProfile().Name,
+			wantLevel: "error",
+		},
+		{
+			name:      "falls back quietly before the profile has loaded",
+			err:       fmt.Errorf("profile unavailable: %w", repository.ErrNotReady),
+			want:      repository.DefaultProfile().Name,
+			wantLevel: "debug",
+		},
+		{
+			name:      "falls back quietly without a profile.json",
+			err:       fmt.Errorf("failed to read profile/profile.json: %w", fs.ErrNotExist),
+			want:      repository.DefaultProfile().Name,
+			wantLevel: "debug",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewProfileService(&mockProfileRepository{profile: tt.profile, err: tt.err})
+
+			var logs bytes.Buffer
+			ctx := zerolog.New(&logs).WithContext(context.Background())
+
+			result := service.GetProfile(ctx)
+
+			assert.NotNil(t, result)
+			assert.Equal(t, tt.want, result.Name)
+			if tt.wantLevel == "" {
+				assert.Empty(t, logs.String())
+			} else {
+				assert.Contains(t, logs.String(), `"level":"`+tt.wantLevel+`"`)
+			}
+		})
+	}
+}
@@ -1,11 +1,76 @@
 package services
 
 import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/benidevo/website/internal/models"
 	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/tracing"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// ProjectFilter narrows a list of projects. Empty fields match every project, and
+// text fields are compared case-insensitively.
+type ProjectFilter struct {
+	Technology string
+	Language   string
+	Featured   *bool
+	Year       int // Matches projects active during the year; 0 matches every project
+}
+
+// Matches reports whether project satisfies every criterion of the filter
+func (f ProjectFilter) Matches(project *models.Project) bool {
+	if f.Language != "" && !strings.EqualFold(project.Language, f.Language) {
+		return false
+	}
+	if f.Featured != nil && project.Featured != *f.Featured {
+		return false
+	}
+	if f.Technology != "" && !project.UsesTechnology(f.Technology) {
+		return false
+	}
+	if f.Year != 0 && !slices.Contains(project.ActiveYears(time.Now().Year()), f.Year) {
+		return false
+	}
+	return true
+}
+
+// ProjectFacets collects the technologies, languages and active years of projects for
+// filtering. Names are sorted alphabetically and years newest first.
+func ProjectFacets(projects []*models.Project) models.ProjectFacets {
+	technologies := map[string]bool{}
+	languages := map[string]bool{}
+	years := map[int]bool{}
+
+	currentYear := time.Now().Year()
+	for _, project := range projects {
+		for _, tech := range project.Technologies {
+			technologies[tech.Name] = true
+		}
+		if project.Language != "" {
+			languages[project.Language] = true
+		}
+		for _, year := range project.ActiveYears(currentYear) {
+			years[year] = true
+		}
+	}
+
+	facets := models.ProjectFacets{
+		Technologies: slices.Sorted(maps.Keys(technologies)),
+		Languages:    slices.Sorted(maps.Keys(languages)),
+		Years:        slices.Sorted(maps.Keys(years)),
+	}
+	slices.Reverse(facets.Years)
+	return facets
+}
+
 // ProjectService provides methods to manage projects, integrating with repositories.
 type ProjectService struct {
 	projectRepo repository.ProjectRepository
@@ -21,9 +86,13 @@ func NewProjectService(projectRepo repository.ProjectRepository, skillRepo repos
 }
 
 // GetFeaturedProjects returns all projects (since all are featured)
-func (p *ProjectService) GetFeaturedProjects() []*models.Project {
-	projects, err := p.projectRepo.GetAllProjects()
+func (p *ProjectService) GetFeaturedProjects(ctx context.Context) []*models.Project {
+	ctx, span := tracing.Start(ctx, "ProjectService.GetFeaturedProjects")
+	defer span.End()
+
+	projects, err := p.projectRepo.GetAllProjects(ctx)
 	if err != nil {
+		span.RecordError(err)
 		log.Error().Err(err).Msg("Failed to get projects")
 		return []*models.Project{}
 	}
@@ -31,11 +100,115 @@ func (p *ProjectService) GetFeaturedProjects() []*models.Project {
 }
 
 // GetSkillCategories returns skill categories for the home page
-func (p *ProjectService) GetSkillCategories() []models.SkillCategory {
-	categories, err := p.skillRepo.GetSkillCategories()
+func (p *ProjectService) GetSkillCategories(ctx context.Context) []models.SkillCategory {
+	ctx, span := tracing.Start(ctx, "ProjectService.GetSkillCategories")
+	defer span.End()
+
+	categories, err := p.skillRepo.GetSkillCategories(ctx)
 	if err != nil {
+		span.RecordError(err)
 		log.Error().Err(err).Msg("Failed to get skill categories")
 		return []models.SkillCategory{}
 	}
 	return categories
 }
+
+// ListProjects returns the projects matching filter
+func (p *ProjectService) ListProjects(ctx context.Context, filter ProjectFilter) (_ []*models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "ProjectService.ListProjects")
+	defer func() { tracing.End(span, err) }()
+
+	projects, err := p.projectRepo.GetAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*models.Project, 0, len(projects))
+	for _, project := range projects {
+		if filter.Matches(project) {
+			matched = append(matched, project)
+		}
+	}
+	return matched, nil
+}
+
+// GetProject returns the project with the given ID, or repository.ErrProjectNotFound
+func (p *ProjectService) GetProject(ctx context.Context, id int) (_ *models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "ProjectService.GetProject")
+	defer func() { tracing.End(span, err) }()
+
+	projects, err := p.projectRepo.GetAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range projects {
+		if project.ID == id {
+			return project, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d", repository.ErrProjectNotFound, id)
+}
+
+// ListSkillCategories returns every skill category
+func (p *ProjectService) ListSkillCategories(ctx context.Context) (_ []models.SkillCategory, err error) {
+	ctx, span := tracing.Start(ctx, "ProjectService.ListSkillCategories")
+	defer func() { tracing.End(span, err) }()
+
+	return p.skillRepo.GetSkillCategories(ctx)
+}
+
+// GetProjectBySlug returns the project with the given slug, or repository.ErrProjectNotFound
+func (p *ProjectService) GetProjectBySlug(ctx context.Context, slug string) (_ *models.Project, err error) {
+	ctx, span := tracing.Start(ctx, "ProjectService.GetProjectBySlug", attribute.String("project.slug", slug))
+	defer func() { tracing.End(span, err) }()
+
+	return p.projectRepo.GetProjectBySlug(ctx, slug)
+}
+
+// RelatedProjects returns up to limit other projects that share the most technologies
+// with project, with a shared language breaking ties. Projects with nothing in common are left out.
+func (p *ProjectService) RelatedProjects(ctx context.Context, project *models.Project, limit int) []*models.Project {
+	ctx, span := tracing.Start(ctx, "ProjectService.RelatedProjects")
+	defer span.End()
+
+	projects, err := p.projectRepo.GetAllProjects(ctx)
+	if err != nil {
+		span.RecordError(err)
+		log.Ctx(ctx).Error().Err(err).Msg("Failed to get related projects")
+		return []*models.Project{}
+	}
+
+	type candidate struct {
+		project *models.Project
+		score   int
+	}
+	var candidates []candidate
+	for _, other := range projects {
+		if other.ID == project.ID {
+			continue
+		}
+		score := 0
+		for _, tech := range project.Technologies {
+			if other.UsesTechnology(tech.Name) {
+				score += 2
+			}
+		}
+		if project.Language != "" && strings.EqualFold(other.Language, project.Language) {
+			score++
+		}
+		if score > 0 {
+			candidates = append(candidates, candidate{project: other, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	related := make([]*models.Project, 0, min(limit, len(candidates)))
+	for _, c := range candidates[:min(limit, len(candidates))] {
+		related = append(related, c.project)
+	}
+	return related
+}
@@ -1,10 +1,13 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,16 +17,28 @@ type mockProjectRepository struct {
 	err      error
 }
 
-func (m *mockProjectRepository) GetAllProjects() ([]*models.Project, error) {
+func (m *mockProjectRepository) GetAllProjects(ctx context.Context) ([]*models.Project, error) {
 	return m.projects, m.err
 }
 
+func (m *mockProjectRepository) GetProjectBySlug(ctx context.Context, slug string) (*models.Project, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	for _, project := range m.projects {
+		if project.Slug == slug {
+			return project, nil
+		}
+	}
+	return nil, repository.ErrProjectNotFound
+}
+
 type mockSkillRepository struct {
 	categories []models.SkillCategory
 	err        error
 }
 
-func (m *mockSkillRepository) GetSkillCategories() ([]models.SkillCategory, error) {
+func (m *mockSkillRepository) GetSkillCategories(ctx context.Context) ([]models.SkillCategory, error) {
 	return m.categories, m.err
 }
 
@@ -57,7 +72,7 @@ func TestProjectService_GetFeaturedProjects(t *testing.T) {
 			}
 			service := NewProjectService(mockRepo, nil)
 
-			result := service.GetFeaturedProjects()
+			result := service.GetFeaturedProjects(context.Background())
 
 			assert.Len(t, result, tt.want)
 			if tt.err == nil && tt.want > 0 {
@@ -97,7 +112,7 @@ func TestProjectService_GetSkillCategories(t *testing.T) {
 			}
 			service := NewProjectService(nil, mockSkillRepo)
 
-			result := service.GetSkillCategories()
+			result := service.GetSkillCategories(context.Background())
 
 			assert.Len(t, result, tt.want)
 			if tt.err == nil && tt.want > 0 {
@@ -106,3 +121,156 @@ func TestProjectService_GetSkillCategories(t *testing.T) {
 		})
 	}
 }
+
+func date(year int, month time.Month) *time.Time {
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestProjectService_ListProjects(t *testing.T) {
+	projects := []*models.Project{
+		{ID: 1, Language: "Go", Featured: true, Technologies: []models.Technology{{Name: "Docker"}, {Name: "PostgreSQL"}}, StartedAt: date(2021, time.May), EndedAt: date(2023, time.June)},
+		{ID: 2, Language: "TypeScript", Featured: false, Technologies: []models.Technology{{Name: "React"}}, StartedAt: date(2023, time.March), EndedAt: date(2023, time.April)},
+		{ID: 3, Language: "Go", Featured: false, Technologies: []models.Technology{{Name: "Redis"}}},
+	}
+	featured, notFeatured := true, false
+
+	tests := []struct {
+		name    string
+		filter  ProjectFilter
+		wantIDs []int
+	}{
+		{name: "no filter", wantIDs: []int{1, 2, 3}},
+		{name: "language ignores case", filter: ProjectFilter{Language: "go"}, wantIDs: []int{1, 3}},
+		{name: "technology", filter: ProjectFilter{Technology: "postgresql"}, wantIDs: []int{1}},
+		{name: "featured", filter: ProjectFilter{Featured: &featured}, wantIDs: []int{1}},
+		{name: "not featured", filter: ProjectFilter{Featured: &notFeatured}, wantIDs: []int{2, 3}},
+		{name: "combined", filter: ProjectFilter{Language: "Go", Featured: &notFeatured}, wantIDs: []int{3}},
+		{name: "year within a project's span", filter: ProjectFilter{Year: 2022}, wantIDs: []int{1}},
+		{name: "year shared by projects", filter: ProjectFilter{Year: 2023}, wantIDs: []int{1, 2}},
+		{name: "year outside every span", filter: ProjectFilter{Year: 2019}, wantIDs: []int{}},
+		{name: "no match", filter: ProjectFilter{Technology: "Kafka"}, wantIDs: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewProjectService(&mockProjectRepository{projects: projects}, nil)
+
+			result, err := service.ListProjects(context.Background(), tt.filter)
+
+			assert.NoError(t, err)
+			ids := make([]int, 0, len(result))
+			for _, project := range result {
+				ids = append(ids, project.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		service := NewProjectService(&mockProjectRepository{err: errors.New("db error")}, nil)
+		_, err := service.ListProjects(context.Background(), ProjectFilter{})
+		assert.EqualError(t, err, "db error")
+	})
+}
+
+func TestProjectFacets(t *testing.T) {
+	currentYear := time.Now().Year()
+	projects := []*models.Project{
+		{Language: "Go", Technologies: []models.Technology{{Name: "Redis"}, {Name: "Docker"}}, StartedAt: date(2021, time.May), EndedAt: date(2022, time.June)},
+		{Language: "Go", Technologies: []models.Technology{{Name: "Docker"}}},
+		{Language: "TypeScript", StartedAt: date(currentYear, time.January)},
+		{},
+	}
+
+	facets := ProjectFacets(projects)
+
+	assert.Equal(t, []string{"Docker", "Redis"}, facets.Technologies)
+	assert.Equal(t, []string{"Go", "TypeScript"}, facets.Languages)
+	assert.Equal(t, []int{currentYear, 2022, 2021}, facets.Years, "ongoing projects count towards the current year")
+}
+
+func TestProjectService_GetProject(t *testing.T) {
+	repo := &mockProjectRepository{projects: []*models.Project{{ID: 1, Title: "One"}, {ID: 2, Title: "Two"}}}
+	service := NewProjectService(repo, nil)
+
+	project, err := service.GetProject(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "Two", project.Title)
+
+	_, err = service.GetProject(context.Background(), 99)
+	assert.ErrorIs(t, err, repository.ErrProjectNotFound)
+
+	repo.err = errors.New("db error")
+	_, err = service.GetProject(context.Background(), 1)
+	assert.EqualError(t, err, "db error")
+}
+
+func TestProjectService_ListSkillCategories(t *testing.T) {
+	categories := []models.SkillCategory{{Category: "Backend"}}
+
+	service := NewProjectService(nil, &mockSkillRepository{categories: categories})
+	result, err := service.ListSkillCategories(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, categories, result)
+
+	service = NewProjectService(nil, &mockSkillRepository{err: errors.New("db error")})
+	_, err = service.ListSkillCategories(context.Background())
+	assert.Error(t, err)
+}
+
+func TestProjectService_GetProjectBySlug(t *testing.T) {
+	repo := &mockProjectRepository{projects: []*models.Project{{ID: 1, Slug: "cache"}}}
+	service := NewProjectService(repo, nil)
+
+	project, err := service.GetProjectBySlug(context.Background(), "cache")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, project.ID)
+
+	_, err = service.GetProjectBySlug(context.Background(), "missing")
+	assert.ErrorIs(t, err, repository.ErrProjectNotFound)
+}
+
+func TestProjectService_RelatedProjects(t *testing.T) {
+	goTech := models.Technology{Name: "Go"}
+	redisTech := models.Technology{Name: "Redis"}
+	reactTech := models.Technology{Name: "React"}
+
+	current := &models.Project{ID: 1, Language: "Go", Technologies: []models.Technology{goTech, redisTech}}
+	projects := []*models.Project{
+		current,
+		{ID: 2, Language: "TypeScript", Technologies: []models.Technology{reactTech}},
+		{ID: 3, Language: "Go"},
+		{ID: 4, Language: "Go", Technologies: []models.Technology{goTech, redisTech}},
+		{ID: 5, Language: "Python", Technologies: []models.Technology{redisTech}},
+	}
+
+	tests := []struct {
+		name    string
+		limit   int
+		wantIDs []int
+	}{
+		{name: "ranks by shared technologies then language", limit: 3, wantIDs: []int{4, 5, 3}},
+		{name: "applies the limit", limit: 1, wantIDs: []int{4}},
+		{name: "limit larger than candidates", limit: 10, wantIDs: []int{4, 5, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewProjectService(&mockProjectRepository{projects: projects}, nil)
+
+			related := service.RelatedProjects(context.Background(), current, tt.limit)
+
+			ids := make([]int, 0, len(related))
+			for _, project := range related {
+				ids = append(ids, project.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+
+	t.Run("returns no projects on repository errors", func(t *testing.T) {
+		service := NewProjectService(&mockProjectRepository{err: errors.New("db error")}, nil)
+		assert.Empty(t, service.RelatedProjects(context.Background(), current, 3))
+	})
+}
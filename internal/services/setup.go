@@ -3,13 +3,20 @@ package services
 import (
 	"fmt"
 
+	"github.com/benidevo/website/internal/client"
 	"github.com/benidevo/website/internal/config"
 	"github.com/benidevo/website/internal/repository"
+	"github.com/rs/zerolog/log"
 )
 
 // Services bundles all application services
 type Services struct {
-	ProjectService *ProjectService
+	ProjectService    *ProjectService
+	PostService       *PostService
+	ProfileService    *ProfileService
+	TechnologyService *TechnologyService
+	ContentService    *ContentService
+	HealthService     *HealthService
 }
 
 // SetupServices initializes and returns all application services with their dependencies
@@ -21,9 +28,35 @@ func SetupServices(cfg *config.Config) (*Services, error) {
 
 	// Create services with repository dependencies
 	projectService := NewProjectService(repos.ProjectRepo, repos.SkillRepo)
+	postService := NewPostService(repos.PostRepo)
+	profileService := NewProfileService(repos.ProfileRepo)
+	technologyService := NewTechnologyService(repos.TechnologyRepo)
+
+	var (
+		upstream upstreamMonitor
+		cache    contentCache
+	)
+	if repos.GitHubClient != nil {
+		upstream = repos.GitHubClient
+		cache = repos.GitHubClient
+	}
+	contentService := NewContentService(repos.ProjectRepo, repos.TechnologyRepo, repos.SkillRepo, repos.PostRepo, repos.ProfileRepo, cache)
+
+	healthService := NewHealthService(repos.Source, cfg.Settings.Content.Directory, upstream, map[string]interface{}{
+		"projects":     repos.ProjectRepo,
+		"technologies": repos.TechnologyRepo,
+		"skills":       repos.SkillRepo,
+		"posts":        repos.PostRepo,
+		"profile":      repos.ProfileRepo,
+	})
 
 	return &Services{
-		ProjectService: projectService,
+		ProjectService:    projectService,
+		PostService:       postService,
+		ProfileService:    profileService,
+		TechnologyService: technologyService,
+		ContentService:    contentService,
+		HealthService:     healthService,
 	}, nil
 }
 
@@ -32,6 +65,10 @@ type repositoryBundle struct {
 	ProjectRepo    repository.ProjectRepository
 	TechnologyRepo repository.TechnologyRepository
 	SkillRepo      repository.SkillRepository
+	PostRepo       repository.PostRepository
+	ProfileRepo    repository.ProfileRepository
+	GitHubClient   *client.GitHubClient // Shared client when content comes from GitHub, nil otherwise
+	Source         string               // Content source in use, with "auto" resolved
 }
 
 // setupRepositories creates and configures all repositories
@@ -40,22 +77,54 @@ func setupRepositories(cfg *config.Config) (*repositoryBundle, error) {
 		projectRepo    repository.ProjectRepository
 		technologyRepo repository.TechnologyRepository
 		skillRepo      repository.SkillRepository
+		postRepo       repository.PostRepository
+		profileRepo    repository.ProfileRepository
+		githubClient   *client.GitHubClient
 	)
 
-	// Determine repository implementation based on GitHub configuration
-	if cfg.Settings.GitHub.Token != "" {
-		technologyRepo = repository.NewGitHubTechnologyRepository(&cfg.Settings.GitHub)
-		skillRepo = repository.NewGitHubSkillRepository(&cfg.Settings.GitHub)
-		projectRepo = repository.NewGitHubProjectRepository(&cfg.Settings.GitHub, technologyRepo)
-	} else {
+	source := cfg.Settings.Content.Source
+	if source == "" || source == config.ContentSourceAuto {
+		source = config.ContentSourceMemory
+		if cfg.Settings.GitHub.Token != "" {
+			source = config.ContentSourceGitHub
+		}
+	}
+
+	switch source {
+	case config.ContentSourceGitHub:
+		// One client is shared so every repository uses the same cache and invalidations
+		githubClient = client.NewGitHubClient(&cfg.Settings.GitHub)
+		technologyRepo = repository.NewGitHubTechnologyRepository(githubClient)
+		skillRepo = repository.NewGitHubSkillRepository(githubClient)
+		projectRepo = repository.NewGitHubProjectRepository(githubClient, technologyRepo)
+		postRepo = repository.NewGitHubPostRepository(githubClient)
+		profileRepo = repository.NewGitHubProfileRepository(githubClient)
+	case config.ContentSourceFilesystem:
+		dir := cfg.Settings.Content.Directory
+		technologyRepo = repository.NewFileSystemTechnologyRepository(dir)
+		skillRepo = repository.NewFileSystemSkillRepository(dir)
+		projectRepo = repository.NewFileSystemProjectRepository(dir, technologyRepo)
+		postRepo = repository.NewFileSystemPostRepository(dir)
+		profileRepo = repository.NewFileSystemProfileRepository(dir)
+	case config.ContentSourceMemory:
 		technologyRepo = repository.NewInMemoryTechnologyRepository()
 		skillRepo = repository.NewInMemorySkillRepository(technologyRepo)
 		projectRepo = repository.NewInMemoryProjectRepository(technologyRepo)
+		postRepo = repository.NewInMemoryPostRepository()
+		profileRepo = repository.NewInMemoryProfileRepository()
+	default:
+		return nil, fmt.Errorf("unknown content source %q", source)
 	}
 
+	log.Info().Str("source", source).Msg("Content repositories configured")
+
 	return &repositoryBundle{
 		ProjectRepo:    projectRepo,
 		TechnologyRepo: technologyRepo,
 		SkillRepo:      skillRepo,
+		PostRepo:       postRepo,
+		ProfileRepo:    profileRepo,
+		GitHubClient:   githubClient,
+		Source:         source,
 	}, nil
 }
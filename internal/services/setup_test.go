@@ -0,0 +1,56 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/benidevo/website/internal/config"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetupRepositories(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     config.ContentConfig
+		wantProject interface{}
+		wantErr     bool
+	}{
+		{
+			name:        "auto falls back to memory without a GitHub token",
+			content:     config.ContentConfig{Source: config.ContentSourceAuto},
+			wantProject: &repository.InMemoryProjectRepository{},
+		},
+		{
+			name:        "filesystem source",
+			content:     config.ContentConfig{Source: config.ContentSourceFilesystem, Directory: t.TempDir()},
+			wantProject: &repository.FileSystemProjectRepository{},
+		},
+		{
+			name:        "memory source",
+			content:     config.ContentConfig{Source: config.ContentSourceMemory},
+			wantProject: &repository.InMemoryProjectRepository{},
+		},
+		{
+			name:    "unknown source",
+			content: config.ContentConfig{Source: "ftp"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Settings: &config.Settings{Content: tt.content},
+			}
+
+			repos, err := setupRepositories(cfg)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.IsType(t, tt.wantProject, repos.ProjectRepo)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/benidevo/website/internal/repository"
+	"github.com/benidevo/website/internal/tracing"
+)
+
+// TechnologyService provides read access to the technologies projects are built with.
+type TechnologyService struct {
+	technologyRepo repository.TechnologyRepository
+}
+
+// NewTechnologyService creates a new technology service
+func NewTechnologyService(technologyRepo repository.TechnologyRepository) *TechnologyService {
+	return &TechnologyService{
+		technologyRepo: technologyRepo,
+	}
+}
+
+// ListTechnologies returns every technology, sorted by name
+func (t *TechnologyService) ListTechnologies(ctx context.Context) (_ []models.Technology, err error) {
+	ctx, span := tracing.Start(ctx, "TechnologyService.ListTechnologies")
+	defer func() { tracing.End(span, err) }()
+
+	byName, err := t.technologyRepo.GetAllTechnologies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	technologies := make([]models.Technology, 0, len(byName))
+	for _, tech := range byName {
+		technologies = append(technologies, tech)
+	}
+	sort.Slice(technologies, func(i, j int) bool {
+		return technologies[i].Name < technologies[j].Name
+	})
+	return technologies, nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/benidevo/website/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockTechnologyRepository struct {
+	technologies map[string]models.Technology
+	err          error
+}
+
+func (m *mockTechnologyRepository) GetTechnology(ctx context.Context, name string) (*models.Technology, error) {
+	tech, ok := m.technologies[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &tech, nil
+}
+
+func (m *mockTechnologyRepository) GetTechnologies(ctx context.Context, names []string) []models.Technology {
+	return nil
+}
+
+func (m *mockTechnologyRepository) GetAllTechnologies(ctx context.Context) (map[string]models.Technology, error) {
+	return m.technologies, m.err
+}
+
+func TestTechnologyService_ListTechnologies(t *testing.T) {
+	service := NewTechnologyService(&mockTechnologyRepository{technologies: map[string]models.Technology{
+		"redis":  {Name: "Redis", Icon: "redis.svg"},
+		"docker": {Name: "Docker", Icon: "docker.svg"},
+		"go":     {Name: "Go", Icon: "go.svg"},
+	}})
+
+	technologies, err := service.ListTechnologies(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Technology{
+		{Name: "Docker", Icon: "docker.svg"},
+		{Name: "Go", Icon: "go.svg"},
+		{Name: "Redis", Icon: "redis.svg"},
+	}, technologies)
+}
+
+func TestTechnologyService_ListTechnologiesError(t *testing.T) {
+	service := NewTechnologyService(&mockTechnologyRepository{err: errors.New("github error")})
+
+	_, err := service.ListTechnologies(context.Background())
+
+	assert.EqualError(t, err, "github error")
+}
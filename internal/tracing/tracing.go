@@ -0,0 +1,152 @@
+// Package tracing configures OpenTelemetry tracing and provides helpers for instrumenting calls
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/benidevo/website/internal/config"
+)
+
+// instrumentationName identifies the spans created by this application
+const instrumentationName = "github.com/benidevo/website"
+
+// otlpTracesPath is appended to OTLP endpoints that are given without a path
+const otlpTracesPath = "/v1/traces"
+
+// ShutdownFunc flushes buffered spans and releases the exporter
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup installs the global tracer provider and W3C trace context propagator.
+//
+// The exporter is chosen by cfg.Exporter: "otlp" sends spans over OTLP/HTTP to
+// cfg.Endpoint, "stdout" pretty-prints them, and "none" disables tracing. "auto"
+// picks OTLP when an endpoint is configured, stdout in development, and none otherwise.
+func Setup(ctx context.Context, cfg config.TracingConfig, isDevelopment bool) (ShutdownFunc, error) {
+	exporterName := resolveExporter(cfg, isDevelopment)
+	if exporterName == config.TracingExporterNone {
+		log.Info().Msg("Tracing disabled")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, exporterName, cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	log.Info().Str("exporter", exporterName).Str("endpoint", cfg.Endpoint).Msg("Tracing enabled")
+
+	return provider.Shutdown, nil
+}
+
+// resolveExporter turns the "auto" exporter setting into a concrete exporter
+func resolveExporter(cfg config.TracingConfig, isDevelopment bool) string {
+	if cfg.Exporter != config.TracingExporterAuto && cfg.Exporter != "" {
+		return cfg.Exporter
+	}
+
+	switch {
+	case cfg.Endpoint != "":
+		return config.TracingExporterOTLP
+	case isDevelopment:
+		return config.TracingExporterStdout
+	default:
+		return config.TracingExporterNone
+	}
+}
+
+// newExporter creates the span exporter with the given name
+func newExporter(ctx context.Context, name, endpoint string) (sdktrace.SpanExporter, error) {
+	switch name {
+	case config.TracingExporterOTLP:
+		endpointURL, err := otlpEndpointURL(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpointURL))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		return exporter, nil
+	case config.TracingExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", name)
+	}
+}
+
+// otlpEndpointURL validates an OTLP/HTTP endpoint, adding the standard traces path
+// when only a base URL such as http://localhost:4318 is given
+func otlpEndpointURL(endpoint string) (string, error) {
+	if endpoint == "" {
+		return "", fmt.Errorf("OTLP tracing requires OTEL_EXPORTER_OTLP_ENDPOINT to be set")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid OTLP endpoint %q: expected a URL such as http://localhost:4318", endpoint)
+	}
+
+	if strings.TrimSuffix(u.Path, "/") == "" {
+		u.Path = otlpTracesPath
+	}
+	return u.String(), nil
+}
+
+// Tracer returns the application's tracer from the global tracer provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts an internal span as a child of any span in ctx
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on the span, if any, and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
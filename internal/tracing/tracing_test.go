@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/benidevo/website/internal/config"
+)
+
+func TestResolveExporter(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           config.TracingConfig
+		isDevelopment bool
+		want          string
+	}{
+		{name: "auto with endpoint", cfg: config.TracingConfig{Exporter: "auto", Endpoint: "http://collector:4318"}, want: "otlp"},
+		{name: "auto in development", cfg: config.TracingConfig{Exporter: "auto"}, isDevelopment: true, want: "stdout"},
+		{name: "auto in production", cfg: config.TracingConfig{Exporter: "auto"}, want: "none"},
+		{name: "explicit exporter wins", cfg: config.TracingConfig{Exporter: "none", Endpoint: "http://collector:4318"}, isDevelopment: true, want: "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, resolveExporter(tt.cfg, tt.isDevelopment))
+		})
+	}
+}
+
+func TestOTLPEndpointURL(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{endpoint: "http://localhost:4318", want: "http://localhost:4318/v1/traces"},
+		{endpoint: "http://localhost:4318/", want: "http://localhost:4318/v1/traces"},
+		{endpoint: "https://collector.example.com/custom/traces", want: "https://collector.example.com/custom/traces"},
+		{endpoint: "", wantErr: true},
+		{endpoint: "localhost:4318", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			got, err := otlpEndpointURL(tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetupExportsToOTLPCollector(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	var (
+		mu       sync.Mutex
+		requests []*http.Request
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	shutdown, err := Setup(context.Background(), config.TracingConfig{
+		Exporter:    config.TracingExporterOTLP,
+		Endpoint:    collector.URL,
+		ServiceName: "website-test",
+		SampleRatio: 1,
+	}, false)
+	require.NoError(t, err)
+
+	_, span := Start(context.Background(), "test-span")
+	span.End()
+
+	require.NoError(t, shutdown(context.Background()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, requests, "collector received no spans")
+	assert.Equal(t, http.MethodPost, requests[0].Method)
+	assert.Equal(t, "/v1/traces", requests[0].URL.Path)
+	assert.Equal(t, "application/x-protobuf", requests[0].Header.Get("Content-Type"))
+}
+
+func TestSetupDisabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), config.TracingConfig{Exporter: config.TracingExporterNone}, true)
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	_, ok := tracer.Start(context.Background(), "ok")
+	End(ok, nil)
+	_, failed := tracer.Start(context.Background(), "failed")
+	End(failed, errors.New("boom"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "boom", spans[1].Status().Description)
+	assert.Len(t, spans[1].Events(), 1)
+}